@@ -0,0 +1,311 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const envPrefix = "FM_"
+
+// Config holds the runtime settings. Values are resolved from defaults, an
+// optional YAML file, FM_* environment variables and command-line flags,
+// each source overriding the previous one.
+type Config struct {
+	ListenAddr   string        `yaml:"listen_addr"`
+	DatabaseDSN  string        `yaml:"database_dsn"`
+	TokenSecret  string        `yaml:"token_secret"`
+	TokenTTL     time.Duration `yaml:"token_ttl"`
+	LogLevel     string        `yaml:"log_level"`
+	CORSOrigins  []string      `yaml:"cors_origins"`
+	ReadTimeout  time.Duration `yaml:"read_timeout"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+
+	// ExportTimeout replaces WriteTimeout for exports, which stream every
+	// matching expense and can take much longer than other responses.
+	ExportTimeout time.Duration `yaml:"export_timeout"`
+
+	// ShutdownTimeout bounds how long in-flight requests are drained after
+	// a termination signal.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+
+	// RecurringInterval is how often due recurring expenses are created.
+	RecurringInterval time.Duration `yaml:"recurring_interval"`
+
+	// WebhookInterval is how often pending webhook deliveries are sent and
+	// WebhookTimeout bounds each delivery request.
+	WebhookInterval time.Duration `yaml:"webhook_interval"`
+	WebhookTimeout  time.Duration `yaml:"webhook_timeout"`
+	// WebhookAllowPrivate lets webhooks be sent to loopback, link-local and
+	// private addresses, which are refused by default.
+	WebhookAllowPrivate bool `yaml:"webhook_allow_private"`
+	// TrashRetention is how long deleted expenses stay in the trash before
+	// they are purged for good, which is checked every PurgeInterval.
+	TrashRetention time.Duration `yaml:"trash_retention"`
+	PurgeInterval  time.Duration `yaml:"purge_interval"`
+}
+
+func Default() *Config {
+	return &Config{
+		ListenAddr:   ":3000",
+		DatabaseDSN:  "./database.db",
+		TokenTTL:     24 * time.Hour,
+		LogLevel:     "info",
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: 15 * time.Second,
+
+		ExportTimeout: 10 * time.Minute,
+
+		ShutdownTimeout: 10 * time.Second,
+
+		RecurringInterval: time.Hour,
+
+		WebhookInterval: 10 * time.Second,
+		WebhookTimeout:  10 * time.Second,
+
+		TrashRetention: 30 * 24 * time.Hour,
+		PurgeInterval:  time.Hour,
+	}
+}
+
+// Load resolves the configuration from args (without the program name) and
+// the environment. It returns the positional arguments left after the flags,
+// such as a subcommand. The result is not validated; call Validate before
+// starting the server.
+func Load(args []string) (*Config, []string, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("financemanager-api", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv(envPrefix+"CONFIG"), "path to a YAML configuration file")
+	flagValues := cfg.registerFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, nil, err
+	}
+
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		if apply, ok := flagValues[f.Name]; ok && flagErr == nil {
+			flagErr = apply()
+		}
+	})
+	if flagErr != nil {
+		return nil, nil, flagErr
+	}
+
+	return cfg, fs.Args(), nil
+}
+
+// registerFlags declares the command-line flags and returns, for each flag
+// name, a function that copies the parsed value into cfg. Only flags that
+// were actually set are applied so that they do not mask file or env values
+// with their defaults.
+func (cfg *Config) registerFlags(fs *flag.FlagSet) map[string]func() error {
+	addr := fs.String("addr", "", "listen address, e.g. :3000")
+	dsn := fs.String("db", "", "SQLite database DSN")
+	secret := fs.String("token-secret", "", "HMAC secret used to sign access tokens")
+	tokenTTL := fs.Duration("token-ttl", 0, "access token lifetime")
+	logLevel := fs.String("log-level", "", "log level: debug, info, warn, error or off")
+	origins := fs.String("cors-origins", "", "comma-separated list of allowed CORS origins")
+	readTimeout := fs.Duration("read-timeout", 0, "HTTP read timeout")
+	writeTimeout := fs.Duration("write-timeout", 0, "HTTP write timeout")
+	exportTimeout := fs.Duration("export-timeout", 0, "HTTP write timeout of exports")
+	shutdownTimeout := fs.Duration("shutdown-timeout", 0, "how long to drain in-flight requests on shutdown")
+	recurringInterval := fs.Duration("recurring-interval", 0, "how often due recurring expenses are created")
+	webhookInterval := fs.Duration("webhook-interval", 0, "how often pending webhook deliveries are sent")
+	webhookTimeout := fs.Duration("webhook-timeout", 0, "timeout of each webhook delivery request")
+	webhookAllowPrivate := fs.Bool("webhook-allow-private", false, "allow webhooks to loopback, link-local and private addresses")
+	trashRetention := fs.Duration("trash-retention", 0, "how long deleted expenses stay in the trash")
+	purgeInterval := fs.Duration("purge-interval", 0, "how often expenses past the trash retention are purged")
+
+	return map[string]func() error{
+		"addr":                  func() error { cfg.ListenAddr = *addr; return nil },
+		"db":                    func() error { cfg.DatabaseDSN = *dsn; return nil },
+		"token-secret":          func() error { cfg.TokenSecret = *secret; return nil },
+		"token-ttl":             func() error { cfg.TokenTTL = *tokenTTL; return nil },
+		"log-level":             func() error { cfg.LogLevel = *logLevel; return nil },
+		"cors-origins":          func() error { cfg.CORSOrigins = splitList(*origins); return nil },
+		"read-timeout":          func() error { cfg.ReadTimeout = *readTimeout; return nil },
+		"write-timeout":         func() error { cfg.WriteTimeout = *writeTimeout; return nil },
+		"export-timeout":        func() error { cfg.ExportTimeout = *exportTimeout; return nil },
+		"shutdown-timeout":      func() error { cfg.ShutdownTimeout = *shutdownTimeout; return nil },
+		"recurring-interval":    func() error { cfg.RecurringInterval = *recurringInterval; return nil },
+		"webhook-interval":      func() error { cfg.WebhookInterval = *webhookInterval; return nil },
+		"webhook-timeout":       func() error { cfg.WebhookTimeout = *webhookTimeout; return nil },
+		"webhook-allow-private": func() error { cfg.WebhookAllowPrivate = *webhookAllowPrivate; return nil },
+		"trash-retention":       func() error { cfg.TrashRetention = *trashRetention; return nil },
+		"purge-interval":        func() error { cfg.PurgeInterval = *purgeInterval; return nil },
+	}
+}
+
+func (cfg *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(cfg); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (cfg *Config) loadEnv() error {
+	stringValues := map[string]*string{
+		"LISTEN_ADDR":  &cfg.ListenAddr,
+		"DATABASE_DSN": &cfg.DatabaseDSN,
+		"TOKEN_SECRET": &cfg.TokenSecret,
+		"LOG_LEVEL":    &cfg.LogLevel,
+	}
+	for name, target := range stringValues {
+		if value, ok := os.LookupEnv(envPrefix + name); ok {
+			*target = value
+		}
+	}
+
+	durations := map[string]*time.Duration{
+		"TOKEN_TTL":          &cfg.TokenTTL,
+		"READ_TIMEOUT":       &cfg.ReadTimeout,
+		"WRITE_TIMEOUT":      &cfg.WriteTimeout,
+		"EXPORT_TIMEOUT":     &cfg.ExportTimeout,
+		"SHUTDOWN_TIMEOUT":   &cfg.ShutdownTimeout,
+		"RECURRING_INTERVAL": &cfg.RecurringInterval,
+		"WEBHOOK_INTERVAL":   &cfg.WebhookInterval,
+		"WEBHOOK_TIMEOUT":    &cfg.WebhookTimeout,
+		"TRASH_RETENTION":    &cfg.TrashRetention,
+		"PURGE_INTERVAL":     &cfg.PurgeInterval,
+	}
+	for name, target := range durations {
+		value, ok := os.LookupEnv(envPrefix + name)
+		if !ok {
+			continue
+		}
+
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s%s: %w", envPrefix, name, err)
+		}
+		*target = d
+	}
+
+	if value, ok := os.LookupEnv(envPrefix + "CORS_ORIGINS"); ok {
+		cfg.CORSOrigins = splitList(value)
+	}
+
+	if value, ok := os.LookupEnv(envPrefix + "WEBHOOK_ALLOW_PRIVATE"); ok {
+		allow, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid %sWEBHOOK_ALLOW_PRIVATE: %w", envPrefix, err)
+		}
+		cfg.WebhookAllowPrivate = allow
+	}
+
+	return nil
+}
+
+// Validate reports every invalid setting at once.
+func (cfg *Config) Validate() error {
+	var errs []error
+
+	if cfg.ListenAddr == "" {
+		errs = append(errs, errors.New("listen address is required"))
+	}
+
+	if cfg.DatabaseDSN == "" {
+		errs = append(errs, errors.New("database DSN is required"))
+	}
+
+	if len(cfg.TokenSecret) < 32 {
+		errs = append(errs, fmt.Errorf("token secret must be at least 32 characters long (set %sTOKEN_SECRET)", envPrefix))
+	}
+
+	if cfg.TokenTTL <= 0 {
+		errs = append(errs, errors.New("token ttl must be greater than zero"))
+	}
+
+	switch cfg.LogLevel {
+	case "debug", "info", "warn", "error", "off":
+	default:
+		errs = append(errs, fmt.Errorf("unknown log level %q", cfg.LogLevel))
+	}
+
+	for _, origin := range cfg.CORSOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			errs = append(errs, fmt.Errorf("invalid CORS origin %q", origin))
+		}
+	}
+
+	if cfg.ReadTimeout <= 0 {
+		errs = append(errs, errors.New("read timeout must be greater than zero"))
+	}
+
+	if cfg.WriteTimeout <= 0 {
+		errs = append(errs, errors.New("write timeout must be greater than zero"))
+	}
+
+	if cfg.ExportTimeout <= 0 {
+		errs = append(errs, errors.New("export timeout must be greater than zero"))
+	}
+
+	if cfg.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown timeout must be greater than zero"))
+	}
+
+	if cfg.RecurringInterval <= 0 {
+		errs = append(errs, errors.New("recurring interval must be greater than zero"))
+	}
+
+	if cfg.WebhookInterval <= 0 {
+		errs = append(errs, errors.New("webhook interval must be greater than zero"))
+	}
+
+	if cfg.WebhookTimeout <= 0 {
+		errs = append(errs, errors.New("webhook timeout must be greater than zero"))
+	}
+
+	if cfg.TrashRetention <= 0 {
+		errs = append(errs, errors.New("trash retention must be greater than zero"))
+	}
+
+	if cfg.PurgeInterval <= 0 {
+		errs = append(errs, errors.New("purge interval must be greater than zero"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
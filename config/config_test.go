@@ -0,0 +1,119 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestLoad_Defaults(t *testing.T) {
+	cfg, rest, err := Load(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, Default(), cfg)
+	assert.Empty(t, rest)
+}
+
+func TestLoad_Precedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+listen_addr: ":4000"
+database_dsn: "/var/lib/fm/file.db"
+log_level: debug
+cors_origins: ["https://file.example.com"]
+read_timeout: 5s
+`), 0o600))
+
+	t.Setenv("FM_CONFIG", path)
+	t.Setenv("FM_DATABASE_DSN", "/var/lib/fm/env.db")
+	t.Setenv("FM_TOKEN_SECRET", testSecret)
+	t.Setenv("FM_READ_TIMEOUT", "7s")
+	t.Setenv("FM_WEBHOOK_ALLOW_PRIVATE", "true")
+
+	cfg, rest, err := Load([]string{"-addr", ":5000", "-cors-origins", "https://a.example.com, https://b.example.com", "migrate", "status"})
+	require.NoError(t, err)
+
+	assert.Equal(t, ":5000", cfg.ListenAddr, "Flags should override the file")
+	assert.Equal(t, "/var/lib/fm/env.db", cfg.DatabaseDSN, "Env should override the file")
+	assert.Equal(t, "debug", cfg.LogLevel, "File should override defaults")
+	assert.Equal(t, 7*time.Second, cfg.ReadTimeout, "Env should override the file")
+	assert.Equal(t, 15*time.Second, cfg.WriteTimeout, "Unset values keep their defaults")
+	assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, cfg.CORSOrigins)
+	assert.Equal(t, testSecret, cfg.TokenSecret)
+	assert.True(t, cfg.WebhookAllowPrivate)
+	assert.Equal(t, []string{"migrate", "status"}, rest)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoad_Errors(t *testing.T) {
+	t.Run("Unknown file field", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("listen_adr: \":4000\"\n"), 0o600))
+
+		_, _, err := Load([]string{"-config", path})
+		assert.Error(t, err)
+	})
+
+	t.Run("Malformed env duration", func(t *testing.T) {
+		t.Setenv("FM_WRITE_TIMEOUT", "soon")
+
+		_, _, err := Load(nil)
+		assert.ErrorContains(t, err, "FM_WRITE_TIMEOUT")
+	})
+
+	t.Run("Malformed env bool", func(t *testing.T) {
+		t.Setenv("FM_WEBHOOK_ALLOW_PRIVATE", "maybe")
+
+		_, _, err := Load(nil)
+		assert.ErrorContains(t, err, "FM_WEBHOOK_ALLOW_PRIVATE")
+	})
+
+	t.Run("Unknown flag", func(t *testing.T) {
+		_, _, err := Load([]string{"-port", "3000"})
+		assert.Error(t, err)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := func() *Config {
+		cfg := Default()
+		cfg.TokenSecret = testSecret
+		return cfg
+	}
+
+	require.NoError(t, valid().Validate())
+
+	tests := []struct {
+		name   string
+		mutate func(cfg *Config)
+	}{
+		{name: "Missing listen address", mutate: func(cfg *Config) { cfg.ListenAddr = "" }},
+		{name: "Missing DSN", mutate: func(cfg *Config) { cfg.DatabaseDSN = "" }},
+		{name: "Short secret", mutate: func(cfg *Config) { cfg.TokenSecret = "secret" }},
+		{name: "Unknown log level", mutate: func(cfg *Config) { cfg.LogLevel = "verbose" }},
+		{name: "Invalid CORS origin", mutate: func(cfg *Config) { cfg.CORSOrigins = []string{"example.com"} }},
+		{name: "Zero read timeout", mutate: func(cfg *Config) { cfg.ReadTimeout = 0 }},
+		{name: "Negative write timeout", mutate: func(cfg *Config) { cfg.WriteTimeout = -time.Second }},
+		{name: "Zero export timeout", mutate: func(cfg *Config) { cfg.ExportTimeout = 0 }},
+		{name: "Zero shutdown timeout", mutate: func(cfg *Config) { cfg.ShutdownTimeout = 0 }},
+		{name: "Zero recurring interval", mutate: func(cfg *Config) { cfg.RecurringInterval = 0 }},
+		{name: "Zero webhook interval", mutate: func(cfg *Config) { cfg.WebhookInterval = 0 }},
+		{name: "Negative webhook timeout", mutate: func(cfg *Config) { cfg.WebhookTimeout = -time.Second }},
+		{name: "Zero trash retention", mutate: func(cfg *Config) { cfg.TrashRetention = 0 }},
+		{name: "Zero purge interval", mutate: func(cfg *Config) { cfg.PurgeInterval = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.mutate(cfg)
+			assert.Error(t, cfg.Validate())
+		})
+	}
+}
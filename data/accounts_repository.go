@@ -0,0 +1,267 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+)
+
+type AccountsSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewAccountsSQLiteRepository(db *sql.DB) *AccountsSQLiteRepository {
+	return &AccountsSQLiteRepository{db: db}
+}
+
+const accountColumns = "id, user_id, name, kind, currency, opening_balance"
+
+// accountMovements lists every movement on account ?2 of user ?1 with a
+// signed amount.
+const accountMovements = `
+	SELECT id, date, 'income' AS kind, source AS description, amount FROM incomes WHERE user_id = ?1 AND account_id = ?2
+	UNION ALL
+	SELECT id, date, 'expense', COALESCE(description, ''), -amount FROM expenses WHERE user_id = ?1 AND account_id = ?2 AND deleted_at IS NULL
+	UNION ALL
+	SELECT id, date, 'transfer_out', description, -amount FROM transfers WHERE user_id = ?1 AND from_account_id = ?2
+	UNION ALL
+	SELECT id, date, 'transfer_in', description, amount FROM transfers WHERE user_id = ?1 AND to_account_id = ?2`
+
+func (r *AccountsSQLiteRepository) FindAll(userID int64) ([]AccountBalance, error) {
+	accounts := make([]AccountBalance, 0)
+
+	rows, err := r.db.Query(
+		`SELECT `+accountColumns+`, opening_balance
+			+ COALESCE((SELECT SUM(amount) FROM incomes i WHERE i.user_id = a.user_id AND i.account_id = a.id), 0)
+			- COALESCE((SELECT SUM(amount) FROM expenses e WHERE e.user_id = a.user_id AND e.account_id = a.id AND e.deleted_at IS NULL), 0)
+			- COALESCE((SELECT SUM(amount) FROM transfers t WHERE t.user_id = a.user_id AND t.from_account_id = a.id), 0)
+			+ COALESCE((SELECT SUM(amount) FROM transfers t WHERE t.user_id = a.user_id AND t.to_account_id = a.id), 0)
+		FROM accounts a
+		WHERE user_id = ?
+		ORDER BY name COLLATE NOCASE, id`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var balance int64
+		account, err := scanIntoAccount(func(dest ...any) error {
+			return rows.Scan(append(dest, &balance)...)
+		})
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, AccountBalance{Account: *account, Balance: balance})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+func (r *AccountsSQLiteRepository) FindByID(userID int64, id string) (*entity.Account, error) {
+	row := r.db.QueryRow("SELECT "+accountColumns+" FROM accounts WHERE id = ? AND user_id = ?", id, userID)
+
+	account, err := scanIntoAccount(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrAccountNotFound, id)
+	}
+
+	return account, err
+}
+
+func (r *AccountsSQLiteRepository) Balance(userID int64, id string, before *time.Time) (int64, error) {
+	account, err := r.FindByID(userID, id)
+	if err != nil {
+		return 0, err
+	}
+
+	var movements int64
+	err = r.db.QueryRow(
+		"SELECT COALESCE(SUM(amount), 0) FROM ("+accountMovements+") WHERE ?3 = '' OR date < ?3",
+		userID, id, formatOptionalDate(before),
+	).Scan(&movements)
+	if err != nil {
+		return 0, err
+	}
+
+	return account.OpeningBalance() + movements, nil
+}
+
+func (r *AccountsSQLiteRepository) Ledger(filter LedgerFilter) ([]LedgerEntry, error) {
+	account, err := r.FindByID(filter.UserID, filter.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The running total covers every movement so that balances stay correct
+	// when only part of the ledger is requested.
+	rows, err := r.db.Query(
+		`WITH ledger AS (
+			SELECT id, date, kind, description, amount,
+				SUM(amount) OVER (ORDER BY date, kind, id ROWS UNBOUNDED PRECEDING) AS running
+			FROM (`+accountMovements+`)
+		)
+		SELECT id, date, kind, description, amount, running FROM ledger
+		WHERE (?3 = '' OR date >= ?3) AND (?4 = '' OR date <= ?4)
+		ORDER BY date, kind, id`,
+		filter.UserID, filter.AccountID, formatOptionalDate(filter.From), formatOptionalDate(filter.To),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]LedgerEntry, 0)
+
+	for rows.Next() {
+		var (
+			entry   LedgerEntry
+			date    string
+			running int64
+		)
+
+		if err := rows.Scan(&entry.ID, &date, &entry.Kind, &entry.Description, &entry.Amount, &running); err != nil {
+			return nil, err
+		}
+
+		if entry.Date, err = time.Parse("2006-01-02", date); err != nil {
+			return nil, err
+		}
+
+		entry.Balance = account.OpeningBalance() + running
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *AccountsSQLiteRepository) Save(account entity.Account) error {
+	_, err := r.db.Exec(
+		"INSERT INTO accounts ("+accountColumns+") VALUES (?, ?, ?, ?, ?, ?)",
+		account.ID(),
+		account.UserID(),
+		account.Name(),
+		string(account.Kind()),
+		account.Currency(),
+		account.OpeningBalance(),
+	)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: %s", ErrAccountNameTaken, account.Name())
+	}
+
+	return err
+}
+
+func (r *AccountsSQLiteRepository) Update(account entity.Account) error {
+	res, err := r.db.Exec(
+		"UPDATE accounts SET name = ?, kind = ?, opening_balance = ? WHERE id = ? AND user_id = ?",
+		account.Name(),
+		string(account.Kind()),
+		account.OpeningBalance(),
+		account.ID(),
+		account.UserID(),
+	)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: %s", ErrAccountNameTaken, account.Name())
+	}
+	if err != nil {
+		return err
+	}
+
+	return expectAffectedAccount(res, account.ID())
+}
+
+func (r *AccountsSQLiteRepository) Delete(userID int64, id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Expenses in the trash count too, so that restoring one never brings
+	// back an expense paid from an account that is gone, and so do
+	// recurring rules, whose expenses could no longer be created.
+	var inUse bool
+	err = tx.QueryRow(
+		`SELECT EXISTS (SELECT 1 FROM expenses WHERE user_id = ?1 AND account_id = ?2)
+			OR EXISTS (SELECT 1 FROM incomes WHERE user_id = ?1 AND account_id = ?2)
+			OR EXISTS (SELECT 1 FROM transfers WHERE user_id = ?1 AND ?2 IN (from_account_id, to_account_id))
+			OR EXISTS (SELECT 1 FROM recurring_rules WHERE user_id = ?1 AND account_id = ?2)`,
+		userID, id,
+	).Scan(&inUse)
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return fmt.Errorf("%w: %s", ErrAccountInUse, id)
+	}
+
+	res, err := tx.Exec("DELETE FROM accounts WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := expectAffectedAccount(res, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func expectAffectedAccount(res sql.Result, id string) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrAccountNotFound, id)
+	}
+
+	return nil
+}
+
+func formatOptionalDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
+
+func scanIntoAccount(scan func(dest ...any) error) (*entity.Account, error) {
+	var (
+		id             string
+		userID         int64
+		name           string
+		kind           string
+		currency       string
+		openingBalance int64
+	)
+
+	if err := scan(&id, &userID, &name, &kind, &currency, &openingBalance); err != nil {
+		return nil, err
+	}
+
+	account, err := entity.NewAccount(userID, name, entity.AccountKind(kind), currency, openingBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	account.SetID(id)
+
+	return account, nil
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	incomeEntity "github.com/MarioGN/finance-manager-api/internal/incomes/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountsSQLiteRepository_CRUD(t *testing.T) {
+	repo := NewAccountsSQLiteRepository(newTestDB(t))
+
+	checking, err := entity.NewAccount(1, "Checking", entity.CheckingAccount, "EUR", 10000)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*checking))
+
+	sameName, err := entity.NewAccount(1, "checking", entity.CashAccount, "EUR", 0)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(*sameName), ErrAccountNameTaken)
+
+	theirs, err := entity.NewAccount(2, "Checking", entity.CheckingAccount, "EUR", 0)
+	require.NoError(t, err)
+	assert.NoError(t, repo.Save(*theirs), "Same name for another user is allowed")
+
+	found, err := repo.FindByID(1, checking.ID())
+	require.NoError(t, err)
+	assert.Equal(t, checking, found)
+
+	_, err = repo.FindByID(2, checking.ID())
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	require.NoError(t, found.SetName("Main"))
+	found.SetOpeningBalance(20000)
+	require.NoError(t, repo.Update(*found))
+
+	updated, err := repo.FindByID(1, checking.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Main", updated.Name())
+	assert.Equal(t, int64(20000), updated.OpeningBalance())
+
+	assert.ErrorIs(t, repo.Delete(2, checking.ID()), ErrAccountNotFound)
+	require.NoError(t, repo.Delete(1, checking.ID()))
+	_, err = repo.FindByID(1, checking.ID())
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+}
+
+func TestAccountsSQLiteRepository_DeleteRefusedForRecurringRules(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewAccountsSQLiteRepository(db)
+	rules := NewRecurringRulesSQLiteRepository(db)
+
+	checking, err := entity.NewAccount(1, "Checking", entity.CheckingAccount, "EUR", 0)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*checking))
+
+	rule := newTestRule(t, 1, "", checking.ID(), testDate(t, "2026-03-01"))
+	require.NoError(t, rules.Save(*rule))
+
+	assert.ErrorIs(t, repo.Delete(1, checking.ID()), ErrAccountInUse, "A rule paying from the account should keep it")
+
+	require.NoError(t, rules.Delete(1, rule.ID()))
+	require.NoError(t, repo.Delete(1, checking.ID()))
+}
+
+func TestAccountsSQLiteRepository_BalancesAndLedger(t *testing.T) {
+	db := newTestDB(t)
+	accounts := NewAccountsSQLiteRepository(db)
+	transfers := NewTransfersSQLiteRepository(db)
+	expenses := NewExpensesSQLiteRepository(db)
+	incomes := NewIncomesSQLiteRepository(db)
+
+	checking, err := entity.NewAccount(1, "Checking", entity.CheckingAccount, "EUR", 100000)
+	require.NoError(t, err)
+	card, err := entity.NewAccount(1, "Card", entity.CreditCardAccount, "EUR", -5000)
+	require.NoError(t, err)
+	require.NoError(t, accounts.Save(*checking))
+	require.NoError(t, accounts.Save(*card))
+
+	salary, err := incomeEntity.NewIncome(1, 300000, "Salary", testDate(t, "2026-01-31"), true)
+	require.NoError(t, err)
+	salary.SetAccountID(checking.ID())
+	require.NoError(t, incomes.Save(*salary))
+
+	rent := newCustomTestExpense(t, 1, 150000, "Rent", "2026-01-05", expenseEntity.FixedExpense)
+	rent.SetAccountID(checking.ID())
+	require.NoError(t, expenses.Save(*rent))
+
+	dinner := newCustomTestExpense(t, 1, 4000, "Dinner", "2026-01-20", expenseEntity.UnplannedExpense)
+	dinner.SetAccountID(card.ID())
+	require.NoError(t, expenses.Save(*dinner))
+
+	unassigned := newCustomTestExpense(t, 1, 999, "Cash", "2026-01-21", expenseEntity.UnplannedExpense)
+	require.NoError(t, expenses.Save(*unassigned))
+
+	payoff, err := entity.NewTransfer(1, checking.ID(), card.ID(), 9000, testDate(t, "2026-02-01"), "")
+	require.NoError(t, err)
+	require.NoError(t, transfers.Save(*payoff))
+
+	all, err := accounts.FindAll(1)
+	require.NoError(t, err)
+	balances := map[string]int64{}
+	for _, a := range all {
+		balances[a.Account.Name()] = a.Balance
+	}
+	assert.Equal(t, map[string]int64{"Checking": 241000, "Card": 0}, balances)
+
+	balance, err := accounts.Balance(1, checking.ID(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(241000), balance)
+
+	before := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	balance, err = accounts.Balance(1, checking.ID(), &before)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-50000), balance, "Only movements before the date should count")
+
+	_, err = accounts.Balance(2, checking.ID(), nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	ledger, err := accounts.Ledger(LedgerFilter{UserID: 1, AccountID: checking.ID(), From: &before})
+	require.NoError(t, err)
+	assert.Equal(t, []LedgerEntry{
+		{ID: salary.ID(), Date: salary.Date(), Kind: LedgerIncome, Description: "Salary", Amount: 300000, Balance: 250000},
+		{ID: payoff.ID(), Date: payoff.Date(), Kind: LedgerTransferOut, Description: "", Amount: -9000, Balance: 241000},
+	}, ledger, "Running balances should include movements before the range")
+
+	assert.ErrorIs(t, accounts.Delete(1, card.ID()), ErrAccountInUse)
+
+	found, err := transfers.FindAll(TransferFilter{UserID: 1, AccountID: card.ID()})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, payoff.ID(), found[0].ID())
+
+	summary, err := NewReportsSQLiteRepository(db).SummarizeExpenses(SummaryFilter{UserID: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(154999), summary.Totals.Total, "Transfers should not count as spending")
+
+	assert.ErrorIs(t, transfers.Delete(2, payoff.ID()), ErrTransferNotFound)
+	require.NoError(t, transfers.Delete(1, payoff.ID()))
+}
@@ -0,0 +1,198 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+)
+
+type BudgetsSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewBudgetsSQLiteRepository(db *sql.DB) *BudgetsSQLiteRepository {
+	return &BudgetsSQLiteRepository{db: db}
+}
+
+const budgetColumns = "id, user_id, month, category_id, expense_type, amount, currency"
+
+func (r *BudgetsSQLiteRepository) FindAll(userID int64) ([]entity.Budget, error) {
+	budgets := make([]entity.Budget, 0)
+
+	rows, err := r.db.Query(
+		"SELECT "+budgetColumns+" FROM budgets WHERE user_id = ? ORDER BY month IS NOT NULL, month, expense_type, category_id, id",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		budget, err := scanIntoBudget(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, *budget)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
+func (r *BudgetsSQLiteRepository) FindByID(userID int64, id string) (*entity.Budget, error) {
+	row := r.db.QueryRow("SELECT "+budgetColumns+" FROM budgets WHERE id = ? AND user_id = ?", id, userID)
+
+	budget, err := scanIntoBudget(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrBudgetNotFound, id)
+	}
+
+	return budget, err
+}
+
+func (r *BudgetsSQLiteRepository) Save(budget entity.Budget) error {
+	_, err := r.db.Exec(
+		"INSERT INTO budgets ("+budgetColumns+") VALUES (?, ?, ?, ?, ?, ?, ?)",
+		budget.ID(),
+		budget.UserID(),
+		nullableString(formatOptionalDate(budget.Month())),
+		nullableString(budget.CategoryID()),
+		nullableString(string(budget.ExpenseType())),
+		budget.Amount(),
+		budget.Currency(),
+	)
+	if isUniqueViolation(err) {
+		return ErrBudgetExists
+	}
+
+	return err
+}
+
+func (r *BudgetsSQLiteRepository) Update(budget entity.Budget) error {
+	res, err := r.db.Exec(
+		"UPDATE budgets SET month = ?, category_id = ?, expense_type = ?, amount = ?, currency = ? WHERE id = ? AND user_id = ?",
+		nullableString(formatOptionalDate(budget.Month())),
+		nullableString(budget.CategoryID()),
+		nullableString(string(budget.ExpenseType())),
+		budget.Amount(),
+		budget.Currency(),
+		budget.ID(),
+		budget.UserID(),
+	)
+	if isUniqueViolation(err) {
+		return ErrBudgetExists
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrBudgetNotFound, budget.ID())
+	}
+
+	return nil
+}
+
+func (r *BudgetsSQLiteRepository) Delete(userID int64, id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM budgets WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrBudgetNotFound, id)
+	}
+
+	if _, err := tx.Exec("DELETE FROM budget_alerts WHERE budget_id = ?", id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *BudgetsSQLiteRepository) ClaimAlert(alert BudgetAlert) error {
+	res, err := r.db.Exec(
+		"INSERT INTO budget_alerts (budget_id, month, threshold) VALUES (?, ?, ?) ON CONFLICT DO NOTHING",
+		alert.BudgetID,
+		alert.Month.Format("2006-01-02"),
+		alert.Threshold,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %d%% in %s", ErrAlertRaised, alert.Threshold, alert.Month.Format("2006-01"))
+	}
+
+	return nil
+}
+
+func (r *BudgetsSQLiteRepository) ReleaseAlert(alert BudgetAlert) error {
+	_, err := r.db.Exec(
+		"DELETE FROM budget_alerts WHERE budget_id = ? AND month = ? AND threshold = ?",
+		alert.BudgetID,
+		alert.Month.Format("2006-01-02"),
+		alert.Threshold,
+	)
+
+	return err
+}
+
+func scanIntoBudget(scan func(dest ...any) error) (*entity.Budget, error) {
+	var (
+		id          string
+		userID      int64
+		month       sql.NullString
+		categoryID  sql.NullString
+		expenseType sql.NullString
+		amount      int64
+		currency    string
+	)
+
+	if err := scan(&id, &userID, &month, &categoryID, &expenseType, &amount, &currency); err != nil {
+		return nil, err
+	}
+
+	parsedMonth, err := parseNullableDate(month)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := entity.NewBudget(userID, parsedMonth, categoryID.String, expenseEntity.ExpenseType(expenseType.String), amount, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	budget.SetID(id)
+
+	return budget, nil
+}
@@ -0,0 +1,126 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetsSQLiteRepository_CRUD(t *testing.T) {
+	repo := NewBudgetsSQLiteRepository(newTestDB(t))
+
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	everyMonth, err := entity.NewBudget(1, nil, "", expenseEntity.VariableExpense, 100000, "BRL")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*everyMonth))
+
+	inMarch, err := entity.NewBudget(1, &march, "", expenseEntity.VariableExpense, 150000, "BRL")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*inMarch))
+
+	duplicate, err := entity.NewBudget(1, nil, "", expenseEntity.VariableExpense, 1, "BRL")
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(*duplicate), ErrBudgetExists)
+
+	theirs, err := entity.NewBudget(2, nil, "", expenseEntity.VariableExpense, 1, "BRL")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*theirs), "Other users have their own budgets")
+
+	found, err := repo.FindByID(1, inMarch.ID())
+	require.NoError(t, err)
+	assert.Equal(t, inMarch, found)
+
+	_, err = repo.FindByID(2, inMarch.ID())
+	assert.ErrorIs(t, err, ErrBudgetNotFound)
+
+	budgets, err := repo.FindAll(1)
+	require.NoError(t, err)
+	require.Len(t, budgets, 2)
+	assert.Nil(t, budgets[0].Month(), "Every-month budgets should come first")
+
+	found.SetMonth(nil)
+	assert.ErrorIs(t, repo.Update(*found), ErrBudgetExists)
+
+	require.NoError(t, found.SetAmount(120000))
+	found.SetMonth(&march)
+	require.NoError(t, repo.Update(*found))
+
+	assert.ErrorIs(t, repo.Delete(2, inMarch.ID()), ErrBudgetNotFound)
+	require.NoError(t, repo.Delete(1, inMarch.ID()))
+	_, err = repo.FindByID(1, inMarch.ID())
+	assert.ErrorIs(t, err, ErrBudgetNotFound)
+}
+
+func TestBudgetsSQLiteRepository_Alerts(t *testing.T) {
+	repo := NewBudgetsSQLiteRepository(newTestDB(t))
+
+	budget, err := entity.NewBudget(1, nil, "", expenseEntity.VariableExpense, 100000, "BRL")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*budget))
+
+	alert := BudgetAlert{BudgetID: budget.ID(), Month: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Threshold: 80}
+	require.NoError(t, repo.ClaimAlert(alert))
+	assert.ErrorIs(t, repo.ClaimAlert(alert), ErrAlertRaised)
+
+	nextMonth := alert
+	nextMonth.Month = alert.Month.AddDate(0, 1, 0)
+	require.NoError(t, repo.ClaimAlert(nextMonth), "Each month should have its own alerts")
+
+	require.NoError(t, repo.ReleaseAlert(alert))
+	require.NoError(t, repo.ClaimAlert(alert), "A released alert can be raised again")
+
+	require.NoError(t, repo.Delete(1, budget.ID()))
+	require.NoError(t, repo.ClaimAlert(alert), "Deleting a budget should clear its alerts")
+}
+
+func TestReportsSQLiteRepository_SumExpenses(t *testing.T) {
+	db := newTestDB(t)
+	categories := NewCategoriesSQLiteRepository(db)
+	expenses := NewExpensesSQLiteRepository(db)
+	repo := NewReportsSQLiteRepository(db)
+
+	food := newTestCategory(t, 1, "Food", "")
+	require.NoError(t, categories.Save(*food))
+	groceries := newTestCategory(t, 1, "Groceries", food.ID())
+	require.NoError(t, categories.Save(*groceries))
+
+	for _, e := range []struct {
+		amount      int64
+		date        string
+		expenseType expenseEntity.ExpenseType
+		categoryID  string
+	}{
+		{1000, "2026-03-02", expenseEntity.VariableExpense, food.ID()},
+		{2000, "2026-03-10", expenseEntity.VariableExpense, groceries.ID()},
+		{4000, "2026-03-31", expenseEntity.FixedExpense, ""},
+		{8000, "2026-04-01", expenseEntity.VariableExpense, food.ID()},
+	} {
+		expense := newCustomTestExpense(t, 1, e.amount, "expense", e.date, e.expenseType)
+		expense.SetCategoryID(e.categoryID)
+		require.NoError(t, expenses.Save(*expense))
+	}
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	total, err := repo.SumExpenses(SpendingFilter{UserID: 1, From: from, To: to})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7000), total)
+
+	total, err = repo.SumExpenses(SpendingFilter{UserID: 1, From: from, To: to, CategoryID: food.ID()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3000), total, "A category should include its subcategories")
+
+	total, err = repo.SumExpenses(SpendingFilter{UserID: 1, From: from, To: to, ExpenseType: expenseEntity.FixedExpense})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4000), total)
+
+	total, err = repo.SumExpenses(SpendingFilter{UserID: 2, From: from, To: to, CategoryID: food.ID()})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
@@ -0,0 +1,183 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/internal/categories/entity"
+)
+
+type CategoriesSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewCategoriesSQLiteRepository(db *sql.DB) *CategoriesSQLiteRepository {
+	return &CategoriesSQLiteRepository{db: db}
+}
+
+const categoryColumns = "id, user_id, name, color, icon, parent_id"
+
+func (r *CategoriesSQLiteRepository) FindAll(userID int64) ([]entity.Category, error) {
+	categories := make([]entity.Category, 0)
+
+	rows, err := r.db.Query("SELECT "+categoryColumns+" FROM categories WHERE user_id = ? ORDER BY name COLLATE NOCASE, id", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		category, err := scanIntoCategory(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, *category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *CategoriesSQLiteRepository) FindByID(userID int64, id string) (*entity.Category, error) {
+	row := r.db.QueryRow("SELECT "+categoryColumns+" FROM categories WHERE id = ? AND user_id = ?", id, userID)
+
+	category, err := scanIntoCategory(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrCategoryNotFound, id)
+	}
+
+	return category, err
+}
+
+func (r *CategoriesSQLiteRepository) Save(category entity.Category) error {
+	_, err := r.db.Exec(
+		"INSERT INTO categories ("+categoryColumns+") VALUES (?, ?, ?, ?, ?, ?)",
+		category.ID(),
+		category.UserID(),
+		category.Name(),
+		category.Color(),
+		category.Icon(),
+		nullableString(category.ParentID()),
+	)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: %s", ErrCategoryNameTaken, category.Name())
+	}
+
+	return err
+}
+
+func (r *CategoriesSQLiteRepository) Update(category entity.Category) error {
+	res, err := r.db.Exec(
+		"UPDATE categories SET name = ?, color = ?, icon = ?, parent_id = ? WHERE id = ? AND user_id = ?",
+		category.Name(),
+		category.Color(),
+		category.Icon(),
+		nullableString(category.ParentID()),
+		category.ID(),
+		category.UserID(),
+	)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: %s", ErrCategoryNameTaken, category.Name())
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrCategoryNotFound, category.ID())
+	}
+
+	return nil
+}
+
+// Delete removes a category, moves its subcategories up to the root and
+// uncategorizes its expenses and recurring rules in a single transaction.
+// Budgets for the category are deleted with it, since without the category
+// they would cover expenses they were never set for.
+func (r *CategoriesSQLiteRepository) Delete(userID int64, id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM categories WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrCategoryNotFound, id)
+	}
+
+	_, err = tx.Exec("UPDATE categories SET parent_id = NULL WHERE parent_id = ? AND user_id = ?", id, userID)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: a subcategory has the name of a top-level category", ErrCategoryNameTaken)
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("UPDATE expenses SET category_id = NULL WHERE category_id = ? AND user_id = ?", id, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("UPDATE recurring_rules SET category_id = NULL WHERE category_id = ? AND user_id = ?", id, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(
+		"DELETE FROM budget_alerts WHERE budget_id IN (SELECT id FROM budgets WHERE category_id = ? AND user_id = ?)",
+		id, userID,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM budgets WHERE category_id = ? AND user_id = ?", id, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func scanIntoCategory(scan func(dest ...any) error) (*entity.Category, error) {
+	var (
+		id       string
+		userID   int64
+		name     string
+		color    string
+		icon     string
+		parentID sql.NullString
+	)
+
+	if err := scan(&id, &userID, &name, &color, &icon, &parentID); err != nil {
+		return nil, err
+	}
+
+	category, err := entity.NewCategory(userID, name, color, icon, parentID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	category.SetID(id)
+
+	return category, nil
+}
+
+func nullableString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	budgetEntity "github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	"github.com/MarioGN/finance-manager-api/internal/categories/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCategory(t *testing.T, userID int64, name, parentID string) *entity.Category {
+	t.Helper()
+
+	category, err := entity.NewCategory(userID, name, "#00ff00", "tag", parentID)
+	require.NoError(t, err)
+
+	return category
+}
+
+func TestCategoriesSQLiteRepository_CRUD(t *testing.T) {
+	repo := NewCategoriesSQLiteRepository(newTestDB(t))
+
+	food := newTestCategory(t, 1, "Food", "")
+	groceries := newTestCategory(t, 1, "Groceries", food.ID())
+	require.NoError(t, repo.Save(*food))
+	require.NoError(t, repo.Save(*groceries))
+
+	found, err := repo.FindByID(1, groceries.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Groceries", found.Name())
+	assert.Equal(t, food.ID(), found.ParentID())
+	assert.Equal(t, "#00ff00", found.Color())
+
+	_, err = repo.FindByID(2, groceries.ID())
+	assert.ErrorIs(t, err, ErrCategoryNotFound)
+
+	all, err := repo.FindAll(1)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	require.NoError(t, found.SetName("Supermarket"))
+	require.NoError(t, found.SetParentID(""))
+	require.NoError(t, repo.Update(*found))
+
+	updated, err := repo.FindByID(1, groceries.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Supermarket", updated.Name())
+	assert.Empty(t, updated.ParentID())
+
+	forged := newTestCategory(t, 2, "Hijacked", "")
+	forged.SetID(food.ID())
+	assert.ErrorIs(t, repo.Update(*forged), ErrCategoryNotFound)
+}
+
+func TestCategoriesSQLiteRepository_UniqueNamePerLevel(t *testing.T) {
+	repo := NewCategoriesSQLiteRepository(newTestDB(t))
+
+	food := newTestCategory(t, 1, "Food", "")
+	require.NoError(t, repo.Save(*food))
+
+	assert.ErrorIs(t, repo.Save(*newTestCategory(t, 1, "food", "")), ErrCategoryNameTaken)
+	assert.NoError(t, repo.Save(*newTestCategory(t, 1, "Food", food.ID())), "Same name under another parent is allowed")
+	assert.NoError(t, repo.Save(*newTestCategory(t, 2, "Food", "")), "Same name for another user is allowed")
+}
+
+func TestCategoriesSQLiteRepository_DeleteRefusesNameClash(t *testing.T) {
+	repo := NewCategoriesSQLiteRepository(newTestDB(t))
+
+	food := newTestCategory(t, 1, "Food", "")
+	home := newTestCategory(t, 1, "Home", "")
+	homeFood := newTestCategory(t, 1, "food", home.ID())
+	for _, c := range []*entity.Category{food, home, homeFood} {
+		require.NoError(t, repo.Save(*c))
+	}
+
+	assert.ErrorIs(t, repo.Delete(1, home.ID()), ErrCategoryNameTaken, "A subcategory cannot move up next to a category of the same name")
+
+	_, err := repo.FindByID(1, home.ID())
+	assert.NoError(t, err, "The category should be kept")
+}
+
+func TestCategoriesSQLiteRepository_DeleteDetachesChildrenAndExpenses(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewCategoriesSQLiteRepository(db)
+	expenses := NewExpensesSQLiteRepository(db)
+	rules := NewRecurringRulesSQLiteRepository(db)
+	budgets := NewBudgetsSQLiteRepository(db)
+
+	food := newTestCategory(t, 1, "Food", "")
+	groceries := newTestCategory(t, 1, "Groceries", food.ID())
+	require.NoError(t, repo.Save(*food))
+	require.NoError(t, repo.Save(*groceries))
+
+	expense := newCustomTestExpense(t, 1, 4200, "Market", "2026-02-15", expenseEntity.VariableExpense)
+	expense.SetCategoryID(food.ID())
+	require.NoError(t, expenses.Save(*expense))
+
+	rule := newTestRule(t, 1, food.ID(), "", testDate(t, "2026-03-01"))
+	require.NoError(t, rules.Save(*rule))
+
+	foodBudget, err := budgetEntity.NewBudget(1, nil, food.ID(), "", 30000, "BRL")
+	require.NoError(t, err)
+	fixed, err := budgetEntity.NewBudget(1, nil, "", expenseEntity.FixedExpense, 100000, "BRL")
+	require.NoError(t, err)
+	require.NoError(t, budgets.Save(*foodBudget))
+	require.NoError(t, budgets.Save(*fixed))
+	require.NoError(t, budgets.ClaimAlert(BudgetAlert{BudgetID: foodBudget.ID(), Month: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), Threshold: 80}))
+
+	assert.ErrorIs(t, repo.Delete(2, food.ID()), ErrCategoryNotFound, "Other users cannot delete the category")
+
+	require.NoError(t, repo.Delete(1, food.ID()))
+
+	_, err = repo.FindByID(1, food.ID())
+	assert.ErrorIs(t, err, ErrCategoryNotFound)
+
+	child, err := repo.FindByID(1, groceries.ID())
+	require.NoError(t, err)
+	assert.Empty(t, child.ParentID(), "Subcategories should move to the top level")
+
+	stored, err := expenses.FindByID(1, expense.ID())
+	require.NoError(t, err)
+	assert.Empty(t, stored.CategoryID(), "Expenses should become uncategorized")
+
+	storedRule, err := rules.FindByID(1, rule.ID())
+	require.NoError(t, err)
+	assert.Empty(t, storedRule.Template().CategoryID, "Recurring rules should become uncategorized")
+
+	_, err = budgets.FindByID(1, foodBudget.ID())
+	assert.ErrorIs(t, err, ErrBudgetNotFound, "Budgets for the category should be deleted")
+	_, err = budgets.FindByID(1, fixed.ID())
+	assert.NoError(t, err, "Other budgets should be kept")
+	assert.NoError(t, budgets.ClaimAlert(BudgetAlert{BudgetID: foodBudget.ID(), Month: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), Threshold: 80}),
+		"Alerts of deleted budgets should be deleted")
+}
@@ -0,0 +1,164 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+type ExchangeRatesSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewExchangeRatesSQLiteRepository(db *sql.DB) *ExchangeRatesSQLiteRepository {
+	return &ExchangeRatesSQLiteRepository{db: db}
+}
+
+const exchangeRateColumns = "user_id, base, quote, date, rate"
+
+func (r *ExchangeRatesSQLiteRepository) FindAll(filter ExchangeRateFilter) ([]entity.ExchangeRate, error) {
+	rates := make([]entity.ExchangeRate, 0)
+
+	conditions := []string{"user_id = ?"}
+	args := []any{filter.UserID}
+
+	if filter.Base != "" {
+		conditions = append(conditions, "base = ?")
+		args = append(args, filter.Base)
+	}
+
+	if filter.Quote != "" {
+		conditions = append(conditions, "quote = ?")
+		args = append(args, filter.Quote)
+	}
+
+	if filter.From != nil {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, filter.From.Format("2006-01-02"))
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, filter.To.Format("2006-01-02"))
+	}
+
+	rows, err := r.db.Query(
+		"SELECT "+exchangeRateColumns+" FROM exchange_rates WHERE "+strings.Join(conditions, " AND ")+" ORDER BY date DESC, base, quote",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rate, err := scanIntoExchangeRate(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, *rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (r *ExchangeRatesSQLiteRepository) Latest(userID int64, base, quote string, date time.Time) (*entity.ExchangeRate, error) {
+	row := r.db.QueryRow(
+		"SELECT "+exchangeRateColumns+" FROM exchange_rates WHERE user_id = ? AND base = ? AND quote = ? AND date <= ? ORDER BY date DESC LIMIT 1",
+		userID, base, quote, date.Format("2006-01-02"),
+	)
+
+	rate, err := scanIntoExchangeRate(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s/%s on or before %s", ErrExchangeRateNotFound, base, quote, date.Format("2006-01-02"))
+	}
+
+	return rate, err
+}
+
+func (r *ExchangeRatesSQLiteRepository) Bases(userID int64) ([]string, error) {
+	bases := make([]string, 0)
+
+	rows, err := r.db.Query("SELECT DISTINCT base FROM exchange_rates WHERE user_id = ? ORDER BY base", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var base string
+		if err := rows.Scan(&base); err != nil {
+			return nil, err
+		}
+		bases = append(bases, base)
+	}
+
+	return bases, rows.Err()
+}
+
+func (r *ExchangeRatesSQLiteRepository) Save(rates []entity.ExchangeRate) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(
+		"INSERT INTO exchange_rates (" + exchangeRateColumns + ") VALUES (?, ?, ?, ?, ?) " +
+			"ON CONFLICT (user_id, base, quote, date) DO UPDATE SET rate = excluded.rate",
+	)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, rate := range rates {
+		_, err := stmt.Exec(
+			rate.UserID(),
+			rate.Base(),
+			rate.Quote(),
+			rate.Date().Format("2006-01-02"),
+			rate.Rate().String(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func scanIntoExchangeRate(scan func(dest ...any) error) (*entity.ExchangeRate, error) {
+	var (
+		userID int64
+		base   string
+		quote  string
+		date   string
+		value  string
+	)
+
+	if err := scan(&userID, &base, &quote, &date, &value); err != nil {
+		return nil, err
+	}
+
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := money.ParseRate(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewExchangeRate(userID, base, quote, parsed, rate)
+}
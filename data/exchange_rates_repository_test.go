@@ -0,0 +1,73 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestExchangeRate(t *testing.T, userID int64, base, quote, date, value string) *entity.ExchangeRate {
+	t.Helper()
+
+	rate, err := money.ParseRate(value)
+	require.NoError(t, err)
+
+	exchangeRate, err := entity.NewExchangeRate(userID, base, quote, testDate(t, date), rate)
+	require.NoError(t, err)
+
+	return exchangeRate
+}
+
+func TestExchangeRatesSQLiteRepository(t *testing.T) {
+	repo := NewExchangeRatesSQLiteRepository(newTestDB(t))
+
+	require.NoError(t, repo.Save([]entity.ExchangeRate{
+		*newTestExchangeRate(t, 1, "EUR", "USD", "2026-01-02", "1.10"),
+		*newTestExchangeRate(t, 1, "EUR", "USD", "2026-01-05", "1.12"),
+		*newTestExchangeRate(t, 1, "EUR", "GBP", "2026-01-05", "0.85"),
+		*newTestExchangeRate(t, 2, "USD", "JPY", "2026-01-05", "150"),
+	}))
+
+	t.Run("Latest rate on or before a date", func(t *testing.T) {
+		rate, err := repo.Latest(1, "EUR", "USD", time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, "1.1", rate.Rate().String())
+
+		rate, err = repo.Latest(1, "EUR", "USD", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, "1.12", rate.Rate().String())
+
+		_, err = repo.Latest(1, "EUR", "USD", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		assert.ErrorIs(t, err, ErrExchangeRateNotFound, "Rates from later dates must not be used")
+
+		_, err = repo.Latest(1, "USD", "JPY", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+		assert.ErrorIs(t, err, ErrExchangeRateNotFound, "Other users' rates must not be used")
+	})
+
+	t.Run("Saving the same pair and date replaces the rate", func(t *testing.T) {
+		require.NoError(t, repo.Save([]entity.ExchangeRate{*newTestExchangeRate(t, 1, "EUR", "GBP", "2026-01-05", "0.86")}))
+
+		rates, err := repo.FindAll(ExchangeRateFilter{UserID: 1, Quote: "GBP"})
+		require.NoError(t, err)
+		require.Len(t, rates, 1)
+		assert.Equal(t, "0.86", rates[0].Rate().String())
+	})
+
+	t.Run("Filter by date range", func(t *testing.T) {
+		from := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+
+		rates, err := repo.FindAll(ExchangeRateFilter{UserID: 1, Base: "EUR", From: &from})
+		require.NoError(t, err)
+		assert.Len(t, rates, 2)
+	})
+
+	t.Run("Bases", func(t *testing.T) {
+		bases, err := repo.Bases(1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"EUR"}, bases)
+	})
+}
@@ -4,9 +4,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -18,10 +20,68 @@ func NewExpensesSQLiteRepository(db *sql.DB) *ExpensesSQLiteRepository {
 	return &ExpensesSQLiteRepository{db: db}
 }
 
-func (r *ExpensesSQLiteRepository) FindAll() ([]entity.Expense, error) {
+const expenseColumns = "id, user_id, amount, currency, description, date, expense_type, category_id, account_id, external_id, version, deleted_at"
+
+func (r *ExpensesSQLiteRepository) FindAll(filter ExpenseFilter) ([]entity.Expense, error) {
+	where, args := buildExpenseWhere(filter)
+	query := "SELECT " + expenseColumns + " FROM expenses" + where + buildExpenseOrderBy(filter)
+
+	if filter.Limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	return r.queryExpenses(query, args...)
+}
+
+// Stream calls fn with each expense matching filter, in order, reading them
+// from a single query instead of loading them all. Tags are read in the same
+// query, so that no other query runs while the rows are open.
+func (r *ExpensesSQLiteRepository) Stream(filter ExpenseFilter, fn func(expense entity.Expense) error) error {
+	where, args := buildExpenseWhere(filter)
+	query := "SELECT " + expenseColumns + `,
+		(SELECT GROUP_CONCAT(t.name, char(31)) FROM expense_tags et JOIN tags t ON t.id = et.tag_id WHERE et.expense_id = expenses.id)
+		FROM expenses` + where + buildExpenseOrderBy(filter)
+
+	if filter.Limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tags sql.NullString
+
+		expense, err := scanIntoExpense(rows, &tags)
+		if err != nil {
+			return err
+		}
+
+		if tags.Valid {
+			if err := expense.SetTags(strings.Split(tags.String, "\x1f")); err != nil {
+				return err
+			}
+		}
+
+		if err := fn(*expense); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// queryExpenses runs a SELECT of expenseColumns and loads the tags of the
+// returned expenses.
+func (r *ExpensesSQLiteRepository) queryExpenses(query string, args ...any) ([]entity.Expense, error) {
 	expenses := make([]entity.Expense, 0)
 
-	rows, err := r.db.Query("SELECT id, amount, description, date, expense_type FROM expenses")
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -38,19 +98,100 @@ func (r *ExpensesSQLiteRepository) FindAll() ([]entity.Expense, error) {
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
+	rows.Close()
+
+	if err := r.loadTags(expenses); err != nil {
+		return nil, err
+	}
 
 	return expenses, nil
 }
 
+func (r *ExpensesSQLiteRepository) loadTags(expenses []entity.Expense) error {
+	if len(expenses) == 0 {
+		return nil
+	}
+
+	index := make(map[string]int, len(expenses))
+	args := make([]any, 0, len(expenses))
+	for i, e := range expenses {
+		index[e.ID()] = i
+		args = append(args, e.ID())
+	}
+
+	rows, err := r.db.Query(
+		"SELECT et.expense_id, t.name FROM expense_tags et JOIN tags t ON t.id = et.tag_id WHERE et.expense_id IN ("+placeholders(len(args))+")",
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]string, len(expenses))
+	for rows.Next() {
+		var expenseID, name string
+		if err := rows.Scan(&expenseID, &name); err != nil {
+			return err
+		}
+		tags[expenseID] = append(tags[expenseID], name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for expenseID, names := range tags {
+		if err := expenses[index[expenseID]].SetTags(names); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ExpensesSQLiteRepository) Count(filter ExpenseFilter) (int64, error) {
+	where, args := buildExpenseWhere(filter)
+
+	var total int64
+	err := r.db.QueryRow("SELECT COUNT(*) FROM expenses"+where, args...).Scan(&total)
+	return total, err
+}
+
 func (r *ExpensesSQLiteRepository) Save(expense entity.Expense) error {
-	res, err := r.db.Exec(
-		"INSERT INTO expenses (id, amount, description, date, expense_type) VALUES (?, ?, ?, ?, ?)",
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertExpense(tx, expense); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertExpense inserts the expense and its tags as part of tx.
+func insertExpense(tx *sql.Tx, expense entity.Expense) error {
+	res, err := tx.Exec(
+		"INSERT INTO expenses ("+expenseColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
 		expense.ID(),
-		float64(expense.Amount())/100.0,
+		expense.UserID(),
+		expense.Amount(),
+		expense.Currency(),
 		expense.Description(),
 		expense.Date().Format("2006-01-02"),
 		string(expense.ExpenseType()),
+		nullableString(expense.CategoryID()),
+		nullableString(expense.AccountID()),
+		nullableString(expense.ExternalID()),
+		expense.Version(),
+		nullableTimestamp(expense.DeletedAt()),
 	)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: %s", ErrExpenseImported, expense.ExternalID())
+	}
 	if err != nil {
 		return err
 	}
@@ -64,62 +205,364 @@ func (r *ExpensesSQLiteRepository) Save(expense entity.Expense) error {
 		return errors.New("no rows affected")
 	}
 
-	return nil
+	return replaceExpenseTags(tx, expense)
 }
 
-func (r *ExpensesSQLiteRepository) FindByID(id string) (*entity.Expense, error) {
-	rows, err := r.db.Query("SELECT id, amount, description, date, expense_type FROM expenses WHERE id = ?", id)
+func (r *ExpensesSQLiteRepository) FindByID(userID int64, id string) (*entity.Expense, error) {
+	expenses, err := r.queryExpenses("SELECT "+expenseColumns+" FROM expenses WHERE id = ? AND user_id = ? AND deleted_at IS NULL", id, userID)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	if rows.Next() {
-		return scanIntoExpense(rows)
+	if len(expenses) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrExpenseNotFound, id)
 	}
 
-	return nil, fmt.Errorf("expense with id %s not found", id)
+	return &expenses[0], nil
 }
 
 func (r *ExpensesSQLiteRepository) Update(expense entity.Expense) error {
-	_, err := r.db.Exec(
-		"UPDATE expenses SET amount = ?, description = ?, date = ?, expense_type = ? WHERE id = ?",
-		float64(expense.Amount())/100.0,
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(
+		"UPDATE expenses SET amount = ?, currency = ?, description = ?, date = ?, expense_type = ?, category_id = ?, account_id = ?, version = version + 1 WHERE id = ? AND user_id = ? AND version = ? AND deleted_at IS NULL",
+		expense.Amount(),
+		expense.Currency(),
 		expense.Description(),
 		expense.Date().Format("2006-01-02"),
 		string(expense.ExpenseType()),
+		nullableString(expense.CategoryID()),
+		nullableString(expense.AccountID()),
+		expense.ID(),
+		expense.UserID(),
+		expense.Version(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := expectAffectedExpense(tx, res, expense.UserID(), expense.ID()); err != nil {
+		return err
+	}
+
+	if err := replaceExpenseTags(tx, expense); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ExpensesSQLiteRepository) FindExternalIDs(userID int64, externalIDs []string) (map[string]string, error) {
+	found := make(map[string]string)
+	if len(externalIDs) == 0 {
+		return found, nil
+	}
+
+	args := make([]any, 0, len(externalIDs)+1)
+	args = append(args, userID)
+	for _, id := range externalIDs {
+		args = append(args, id)
+	}
+
+	rows, err := r.db.Query(
+		"SELECT external_id, id FROM expenses WHERE user_id = ? AND external_id IN ("+placeholders(len(externalIDs))+")",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var externalID, expenseID string
+		if err := rows.Scan(&externalID, &expenseID); err != nil {
+			return nil, err
+		}
+		found[externalID] = expenseID
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (r *ExpensesSQLiteRepository) Trash(expense entity.Expense) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(
+		"UPDATE expenses SET deleted_at = ?, version = version + 1 WHERE id = ? AND user_id = ? AND version = ? AND deleted_at IS NULL",
+		formatTimestamp(expense.DeletedAt()),
 		expense.ID(),
+		expense.UserID(),
+		expense.Version(),
 	)
 	if err != nil {
 		return err
 	}
 
+	if err := expectAffectedExpense(tx, res, expense.UserID(), expense.ID()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ExpensesSQLiteRepository) Restore(userID int64, id string) error {
+	res, err := r.db.Exec(
+		"UPDATE expenses SET deleted_at = NULL, version = version + 1 WHERE id = ? AND user_id = ? AND deleted_at IS NOT NULL",
+		id, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrExpenseNotFound, id)
+	}
+
+	return nil
+}
+
+func (r *ExpensesSQLiteRepository) Purge(before time.Time) (int64, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	cutoff := formatTimestamp(before)
+
+	if _, err := tx.Exec("DELETE FROM expense_tags WHERE expense_id IN (SELECT id FROM expenses WHERE deleted_at < ?)", cutoff); err != nil {
+		return 0, err
+	}
+
+	// The import rows and recurring dates an expense came from outlive it.
+	for _, table := range []string{"import_rows", "recurring_occurrences"} {
+		if _, err := tx.Exec(
+			"UPDATE "+table+" SET expense_id = NULL WHERE expense_id IN (SELECT id FROM expenses WHERE deleted_at < ?)",
+			cutoff,
+		); err != nil {
+			return 0, err
+		}
+	}
+
+	res, err := tx.Exec("DELETE FROM expenses WHERE deleted_at < ?", cutoff)
+	if err != nil {
+		return 0, err
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, tx.Commit()
+}
+
+// replaceExpenseTags links the expense to exactly its current tags,
+// creating any of the owner's tags that do not exist yet.
+func replaceExpenseTags(tx *sql.Tx, expense entity.Expense) error {
+	if _, err := tx.Exec("DELETE FROM expense_tags WHERE expense_id = ?", expense.ID()); err != nil {
+		return err
+	}
+
+	for _, name := range expense.Tags() {
+		_, err := tx.Exec(
+			"INSERT INTO tags (id, user_id, name) VALUES (?, ?, ?) ON CONFLICT (user_id, name) DO NOTHING",
+			uuid.New().String(), expense.UserID(), name,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(
+			"INSERT INTO expense_tags (expense_id, tag_id) SELECT ?, id FROM tags WHERE user_id = ? AND name = ?",
+			expense.ID(), expense.UserID(), name,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (r *ExpensesSQLiteRepository) Delete(id string) error {
-	_, err := r.db.Exec("DELETE FROM expenses WHERE id = ?", id)
-	return err
+func buildExpenseWhere(filter ExpenseFilter) (string, []any) {
+	conditions := []string{"user_id = ?", "deleted_at IS NULL"}
+	args := []any{filter.UserID}
+
+	if filter.Trashed {
+		conditions[1] = "deleted_at IS NOT NULL"
+	}
+
+	if filter.From != nil {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, filter.From.Format("2006-01-02"))
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, filter.To.Format("2006-01-02"))
+	}
+
+	if filter.ExpenseType != "" {
+		conditions = append(conditions, "expense_type = ?")
+		args = append(args, string(filter.ExpenseType))
+	}
+
+	if filter.CategoryID != "" {
+		conditions = append(conditions, "category_id = ?")
+		args = append(args, filter.CategoryID)
+	}
+
+	if filter.AccountID != "" {
+		conditions = append(conditions, "account_id = ?")
+		args = append(args, filter.AccountID)
+	}
+
+	if len(filter.AnyTags) > 0 {
+		conditions = append(conditions, "id IN (SELECT et.expense_id FROM expense_tags et JOIN tags t ON t.id = et.tag_id WHERE t.user_id = ? AND t.name IN ("+placeholders(len(filter.AnyTags))+"))")
+		args = append(args, filter.UserID)
+		args = appendStrings(args, filter.AnyTags)
+	}
+
+	if len(filter.AllTags) > 0 {
+		conditions = append(conditions, "id IN (SELECT et.expense_id FROM expense_tags et JOIN tags t ON t.id = et.tag_id WHERE t.user_id = ? AND t.name IN ("+placeholders(len(filter.AllTags))+") GROUP BY et.expense_id HAVING COUNT(*) = ?)")
+		args = append(args, filter.UserID)
+		args = appendStrings(args, filter.AllTags)
+		args = append(args, len(filter.AllTags))
+	}
+
+	if filter.AmountMin != nil {
+		conditions = append(conditions, "amount >= ?")
+		args = append(args, *filter.AmountMin)
+	}
+
+	if filter.AmountMax != nil {
+		conditions = append(conditions, "amount <= ?")
+		args = append(args, *filter.AmountMax)
+	}
+
+	if filter.Description != "" {
+		conditions = append(conditions, `description LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+likeEscaper.Replace(filter.Description)+"%")
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// nullableTimestamp is formatTimestamp of t, or NULL for the zero time.
+func nullableTimestamp(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return formatTimestamp(t)
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func appendStrings(args []any, values []string) []any {
+	for _, v := range values {
+		args = append(args, v)
+	}
+	return args
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func buildExpenseOrderBy(filter ExpenseFilter) string {
+	column := string(SortByDate)
+	if filter.SortField.IsValid() || (filter.Trashed && filter.SortField == SortByDeletedAt) {
+		column = string(filter.SortField)
+	}
+
+	direction := "ASC"
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+
+	// id breaks ties so that pages stay stable between requests.
+	return fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+}
+
+// expectAffectedExpense tells apart an expense that does not exist, or is in
+// the trash, from one whose version changed when a statement matching both
+// affected no rows.
+func expectAffectedExpense(tx *sql.Tx, res sql.Result, userID int64, id string) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	var exists bool
+	err = tx.QueryRow("SELECT EXISTS (SELECT 1 FROM expenses WHERE id = ? AND user_id = ? AND deleted_at IS NULL)", id, userID).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("%w: %s", ErrExpenseChanged, id)
+	}
+	return fmt.Errorf("%w: %s", ErrExpenseNotFound, id)
 }
 
-func scanIntoExpense(rows *sql.Rows) (*entity.Expense, error) {
+// scanIntoExpense scans expenseColumns, followed by any extra columns into
+// extra.
+func scanIntoExpense(rows *sql.Rows, extra ...any) (*entity.Expense, error) {
 	type RowStruct struct {
 		ID          string
-		Amount      float64
+		UserID      int64
+		Amount      int64
+		Currency    string
 		Description string
 		Date        string
 		ExpenseType string
+		CategoryID  sql.NullString
+		AccountID   sql.NullString
+		ExternalID  sql.NullString
+		Version     int64
+		DeletedAt   sql.NullString
 	}
 
 	var rowStruct RowStruct
 
-	err := rows.Scan(
+	dest := []any{
 		&rowStruct.ID,
+		&rowStruct.UserID,
 		&rowStruct.Amount,
+		&rowStruct.Currency,
 		&rowStruct.Description,
 		&rowStruct.Date,
 		&rowStruct.ExpenseType,
-	)
+		&rowStruct.CategoryID,
+		&rowStruct.AccountID,
+		&rowStruct.ExternalID,
+		&rowStruct.Version,
+		&rowStruct.DeletedAt,
+	}
+
+	err := rows.Scan(append(dest, extra...)...)
 
 	if err != nil {
 		return nil, err
@@ -131,7 +574,8 @@ func scanIntoExpense(rows *sql.Rows) (*entity.Expense, error) {
 	}
 
 	expense, err := entity.NewExpense(
-		int64(rowStruct.Amount)*100,
+		rowStruct.UserID,
+		rowStruct.Amount,
 		rowStruct.Description,
 		date,
 		entity.ExpenseType(rowStruct.ExpenseType),
@@ -141,7 +585,23 @@ func scanIntoExpense(rows *sql.Rows) (*entity.Expense, error) {
 		return nil, err
 	}
 
+	if err := expense.SetCurrency(rowStruct.Currency); err != nil {
+		return nil, err
+	}
+
 	expense.SetID(rowStruct.ID)
+	expense.SetCategoryID(rowStruct.CategoryID.String)
+	expense.SetAccountID(rowStruct.AccountID.String)
+	expense.SetExternalID(rowStruct.ExternalID.String)
+	expense.SetVersion(rowStruct.Version)
+
+	deletedAt, err := parseNullableTimestamp(rowStruct.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+	if deletedAt != nil {
+		expense.SetDeletedAt(*deletedAt)
+	}
 
 	return expense, nil
 }
@@ -0,0 +1,314 @@
+package data
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	importEntity "github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestExpense(t *testing.T, userID int64) *entity.Expense {
+	t.Helper()
+	return newCustomTestExpense(t, userID, 1500, "Groceries", "2026-03-14", entity.VariableExpense)
+}
+
+func newCustomTestExpense(t *testing.T, userID, amount int64, description, date string, expenseType entity.ExpenseType) *entity.Expense {
+	t.Helper()
+
+	expense, err := entity.NewExpense(userID, amount, description, testDate(t, date), expenseType)
+	require.NoError(t, err)
+
+	return expense
+}
+
+func TestExpensesSQLiteRepository_ScopedToOwner(t *testing.T) {
+	repo := NewExpensesSQLiteRepository(newTestDB(t))
+
+	mine := newTestExpense(t, 1)
+	theirs := newTestExpense(t, 2)
+	require.NoError(t, repo.Save(*mine))
+	require.NoError(t, repo.Save(*theirs))
+
+	t.Run("FindAll only returns the owner's expenses", func(t *testing.T) {
+		expenses, err := repo.FindAll(ExpenseFilter{UserID: 1})
+		require.NoError(t, err)
+		require.Len(t, expenses, 1)
+		assert.Equal(t, mine.ID(), expenses[0].ID())
+		assert.Equal(t, int64(1), expenses[0].UserID())
+	})
+
+	t.Run("FindByID does not return other users' expenses", func(t *testing.T) {
+		_, err := repo.FindByID(1, theirs.ID())
+		assert.ErrorIs(t, err, ErrExpenseNotFound)
+
+		found, err := repo.FindByID(2, theirs.ID())
+		require.NoError(t, err)
+		assert.Equal(t, theirs.ID(), found.ID())
+	})
+
+	t.Run("Update does not touch other users' expenses", func(t *testing.T) {
+		forged := newTestExpense(t, 1)
+		forged.SetID(theirs.ID())
+		forged.SetDescription("Hijacked")
+
+		assert.ErrorIs(t, repo.Update(*forged), ErrExpenseNotFound)
+
+		stored, err := repo.FindByID(2, theirs.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Groceries", stored.Description())
+	})
+
+	t.Run("Trash does not remove other users' expenses", func(t *testing.T) {
+		forged := newTestExpense(t, 1)
+		forged.SetID(theirs.ID())
+		forged.SetDeletedAt(time.Now())
+
+		assert.ErrorIs(t, repo.Trash(*forged), ErrExpenseNotFound)
+		assert.ErrorIs(t, repo.Restore(1, theirs.ID()), ErrExpenseNotFound)
+
+		_, err := repo.FindByID(2, theirs.ID())
+		assert.NoError(t, err)
+	})
+}
+
+func TestExpensesSQLiteRepository_Versions(t *testing.T) {
+	repo := NewExpensesSQLiteRepository(newTestDB(t))
+
+	expense := newTestExpense(t, 1)
+	require.NoError(t, repo.Save(*expense))
+
+	first, err := repo.FindByID(1, expense.ID())
+	require.NoError(t, err)
+	second, err := repo.FindByID(1, expense.ID())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first.Version())
+
+	first.SetDescription("First")
+	require.NoError(t, repo.Update(*first))
+
+	second.SetDescription("Second")
+	assert.ErrorIs(t, repo.Update(*second), ErrExpenseChanged, "An update of a stale version should be refused")
+	second.SetDeletedAt(time.Now())
+	assert.ErrorIs(t, repo.Trash(*second), ErrExpenseChanged, "Trashing a stale version should be refused")
+
+	stored, err := repo.FindByID(1, expense.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "First", stored.Description())
+	assert.Equal(t, int64(2), stored.Version())
+
+	stored.SetDeletedAt(time.Now())
+	require.NoError(t, repo.Trash(*stored))
+	assert.ErrorIs(t, repo.Trash(*stored), ErrExpenseNotFound, "An expense in the trash cannot be trashed again")
+}
+
+func TestExpensesSQLiteRepository_Trash(t *testing.T) {
+	repo := NewExpensesSQLiteRepository(newTestDB(t))
+
+	kept := newTestExpense(t, 1)
+	old := newTestExpense(t, 1)
+	recent := newTestExpense(t, 1)
+	for _, e := range []*entity.Expense{kept, old, recent} {
+		require.NoError(t, e.SetTags([]string{"food"}))
+		require.NoError(t, repo.Save(*e))
+	}
+
+	deletedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	old.SetDeletedAt(deletedAt)
+	require.NoError(t, repo.Trash(*old))
+	recent.SetDeletedAt(deletedAt.Add(48 * time.Hour))
+	require.NoError(t, repo.Trash(*recent))
+
+	_, err := repo.FindByID(1, old.ID())
+	assert.ErrorIs(t, err, ErrExpenseNotFound, "A trashed expense should not be found")
+
+	live, err := repo.FindAll(ExpenseFilter{UserID: 1})
+	require.NoError(t, err)
+	require.Len(t, live, 1)
+	assert.Equal(t, kept.ID(), live[0].ID())
+
+	trashed, err := repo.FindAll(ExpenseFilter{UserID: 1, Trashed: true, SortField: SortByDeletedAt})
+	require.NoError(t, err)
+	require.Len(t, trashed, 2)
+	assert.Equal(t, old.ID(), trashed[0].ID())
+	assert.True(t, deletedAt.Equal(trashed[0].DeletedAt()))
+	assert.Equal(t, []string{"food"}, trashed[0].Tags(), "Tags should be kept in the trash")
+
+	purged, err := repo.Purge(deletedAt.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	assert.ErrorIs(t, repo.Restore(1, old.ID()), ErrExpenseNotFound, "A purged expense cannot be restored")
+
+	require.NoError(t, repo.Restore(1, recent.ID()))
+	restored, err := repo.FindByID(1, recent.ID())
+	require.NoError(t, err)
+	assert.True(t, restored.DeletedAt().IsZero())
+	assert.Equal(t, []string{"food"}, restored.Tags())
+	assert.ErrorIs(t, repo.Restore(1, recent.ID()), ErrExpenseNotFound, "Only an expense in the trash can be restored")
+
+	count, err := repo.Count(ExpenseFilter{UserID: 1, Trashed: true})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestExpensesSQLiteRepository_PurgeDetachesReferences(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewExpensesSQLiteRepository(db)
+	imports := NewImportsSQLiteRepository(db)
+	rules := NewRecurringRulesSQLiteRepository(db)
+
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	date := time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	imp, err := importEntity.NewImport(1, "csv", "april.csv", []importEntity.Row{importEntity.NewRow(2, date, 1500, "Groceries")}, now)
+	require.NoError(t, err)
+	require.NoError(t, imports.Save(*imp))
+
+	imported := newCustomTestExpense(t, 1, 1500, "Groceries", "2026-04-02", entity.VariableExpense)
+	imp.MarkCommitted(map[int]string{2: imported.ID()}, now)
+	require.NoError(t, imports.Commit(*imp, []entity.Expense{*imported}))
+
+	rule := newTestRule(t, 1, "", "", testDate(t, "2026-03-01"))
+	require.NoError(t, rules.Save(*rule))
+	recurring := newCustomTestExpense(t, 1, 4500, "Gym", "2026-04-01", entity.FixedExpense)
+	require.NoError(t, repo.Save(*recurring))
+	require.NoError(t, rules.ClaimOccurrence(Occurrence{RuleID: rule.ID(), Date: recurring.Date(), Status: OccurrenceCreated}))
+	require.NoError(t, rules.SetOccurrenceExpense(rule.ID(), recurring.Date(), recurring.ID()))
+
+	for _, e := range []*entity.Expense{imported, recurring} {
+		e.SetDeletedAt(now)
+		require.NoError(t, repo.Trash(*e))
+	}
+
+	purged, err := repo.Purge(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+
+	committed, err := imports.FindByID(1, imp.ID())
+	require.NoError(t, err)
+	assert.Empty(t, committed.Rows()[0].ExpenseID, "Import rows should no longer point at purged expenses")
+
+	occurrences, err := rules.Occurrences(rule.ID(), recurring.Date(), recurring.Date())
+	require.NoError(t, err)
+	require.Len(t, occurrences, 1, "The date should stay taken so it is not created again")
+	assert.Empty(t, occurrences[0].ExpenseID, "Occurrences should no longer point at purged expenses")
+}
+
+func TestExpensesSQLiteRepository_FindAllFilters(t *testing.T) {
+	repo := NewExpensesSQLiteRepository(newTestDB(t))
+
+	seed := []*entity.Expense{
+		newCustomTestExpense(t, 1, 150000, "Rent", "2026-01-05", entity.FixedExpense),
+		newCustomTestExpense(t, 1, 8500, "Electricity bill", "2026-01-20", entity.VariableExpense),
+		newCustomTestExpense(t, 1, 2500, "Dinner 100% off_peak", "2026-02-02", entity.UnplannedExpense),
+		newCustomTestExpense(t, 1, 4200, "Groceries", "2026-02-15", entity.VariableExpense),
+		newCustomTestExpense(t, 2, 9900, "Groceries", "2026-02-15", entity.VariableExpense),
+	}
+	for _, e := range seed {
+		require.NoError(t, repo.Save(*e))
+	}
+
+	date := func(s string) *time.Time {
+		d := testDate(t, s)
+		return &d
+	}
+	cents := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name          string
+		filter        ExpenseFilter
+		expectedDescs []string
+		expectedTotal int64
+	}{
+		{
+			name:          "Default order is by date ascending",
+			filter:        ExpenseFilter{UserID: 1},
+			expectedDescs: []string{"Rent", "Electricity bill", "Dinner 100% off_peak", "Groceries"},
+			expectedTotal: 4,
+		},
+		{
+			name:          "Date range is inclusive",
+			filter:        ExpenseFilter{UserID: 1, From: date("2026-01-20"), To: date("2026-02-02")},
+			expectedDescs: []string{"Electricity bill", "Dinner 100% off_peak"},
+			expectedTotal: 2,
+		},
+		{
+			name:          "Expense type",
+			filter:        ExpenseFilter{UserID: 1, ExpenseType: entity.VariableExpense},
+			expectedDescs: []string{"Electricity bill", "Groceries"},
+			expectedTotal: 2,
+		},
+		{
+			name:          "Amount range sorted by amount descending",
+			filter:        ExpenseFilter{UserID: 1, AmountMin: cents(2500), AmountMax: cents(8500), SortField: SortByAmount, SortDesc: true},
+			expectedDescs: []string{"Electricity bill", "Groceries", "Dinner 100% off_peak"},
+			expectedTotal: 3,
+		},
+		{
+			name:          "Description wildcards are matched literally",
+			filter:        ExpenseFilter{UserID: 1, Description: "100%"},
+			expectedDescs: []string{"Dinner 100% off_peak"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "Pagination keeps the unpaged total",
+			filter:        ExpenseFilter{UserID: 1, Limit: 2, Offset: 1},
+			expectedDescs: []string{"Electricity bill", "Dinner 100% off_peak"},
+			expectedTotal: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expenses, err := repo.FindAll(tt.filter)
+			require.NoError(t, err)
+
+			descs := make([]string, 0, len(expenses))
+			for _, e := range expenses {
+				descs = append(descs, e.Description())
+			}
+			assert.Equal(t, tt.expectedDescs, descs)
+
+			total, err := repo.Count(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+}
+
+func TestExpensesSQLiteRepository_Stream(t *testing.T) {
+	repo := NewExpensesSQLiteRepository(newTestDB(t))
+
+	rent := newCustomTestExpense(t, 1, 150000, "Rent", "2026-01-05", entity.FixedExpense)
+	require.NoError(t, rent.SetTags([]string{"home", "Monthly"}))
+	groceries := newCustomTestExpense(t, 1, 4200, "Groceries", "2026-02-15", entity.VariableExpense)
+	other := newCustomTestExpense(t, 2, 9900, "Groceries", "2026-02-15", entity.VariableExpense)
+
+	for _, e := range []*entity.Expense{rent, groceries, other} {
+		require.NoError(t, repo.Save(*e))
+	}
+
+	var streamed []entity.Expense
+	err := repo.Stream(ExpenseFilter{UserID: 1, SortField: SortByDate, SortDesc: true}, func(e entity.Expense) error {
+		streamed = append(streamed, e)
+		return nil
+	})
+	require.NoError(t, err)
+
+	expected, err := repo.FindAll(ExpenseFilter{UserID: 1, SortField: SortByDate, SortDesc: true})
+	require.NoError(t, err)
+	assert.Equal(t, expected, streamed, "Stream should return what FindAll does")
+	assert.Equal(t, []string{"home", "Monthly"}, streamed[1].Tags())
+
+	stop := errors.New("stop")
+	calls := 0
+	err = repo.Stream(ExpenseFilter{UserID: 1}, func(e entity.Expense) error {
+		calls++
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,235 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+)
+
+type ImportsSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewImportsSQLiteRepository(db *sql.DB) *ImportsSQLiteRepository {
+	return &ImportsSQLiteRepository{db: db}
+}
+
+const (
+	importColumns    = "id, user_id, source, filename, status, created_at, committed_at"
+	importRowColumns = "line, date, amount, description, hash, external_id, currency, expense_type, status, error, expense_id"
+)
+
+func (r *ImportsSQLiteRepository) FindByID(userID int64, id string) (*entity.Import, error) {
+	var (
+		source      string
+		filename    string
+		status      string
+		createdAt   string
+		committedAt sql.NullString
+	)
+
+	err := r.db.QueryRow("SELECT "+importColumns+" FROM imports WHERE id = ? AND user_id = ?", id, userID).
+		Scan(&id, &userID, &source, &filename, &status, &createdAt, &committedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrImportNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	committed, err := parseNullableTimestamp(committedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.findRows(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.RestoreImport(id, userID, source, filename, entity.ImportStatus(status), created, committed, rows), nil
+}
+
+func (r *ImportsSQLiteRepository) findRows(importID string) ([]entity.Row, error) {
+	rows, err := r.db.Query("SELECT "+importRowColumns+" FROM import_rows WHERE import_id = ? ORDER BY line", importID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]entity.Row, 0)
+
+	for rows.Next() {
+		var (
+			row         entity.Row
+			date        sql.NullString
+			expenseType string
+			status      string
+			expenseID   sql.NullString
+		)
+
+		if err := rows.Scan(&row.Line, &date, &row.Amount, &row.Description, &row.Hash, &row.ExternalID, &row.Currency, &expenseType, &status, &row.Error, &expenseID); err != nil {
+			return nil, err
+		}
+
+		parsed, err := parseNullableDate(date)
+		if err != nil {
+			return nil, err
+		}
+		if parsed != nil {
+			row.Date = *parsed
+		}
+
+		row.ExpenseType = expenseEntity.ExpenseType(expenseType)
+		row.Status = entity.RowStatus(status)
+		row.ExpenseID = expenseID.String
+		result = append(result, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *ImportsSQLiteRepository) Save(imp entity.Import) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
+		"INSERT INTO imports ("+importColumns+") VALUES (?, ?, ?, ?, ?, ?, ?)",
+		imp.ID(),
+		imp.UserID(),
+		imp.Source(),
+		imp.Filename(),
+		string(imp.Status()),
+		formatTimestamp(imp.CreatedAt()),
+		nullableString(formatOptionalTimestamp(imp.CommittedAt())),
+	)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO import_rows (import_id, " + importRowColumns + ") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, row := range imp.Rows() {
+		var date string
+		if !row.Date.IsZero() {
+			date = row.Date.Format("2006-01-02")
+		}
+
+		_, err := stmt.Exec(
+			imp.ID(),
+			row.Line,
+			nullableString(date),
+			row.Amount,
+			row.Description,
+			row.Hash,
+			row.ExternalID,
+			row.Currency,
+			string(row.ExpenseType),
+			string(row.Status),
+			row.Error,
+			nullableString(row.ExpenseID),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *ImportsSQLiteRepository) Commit(imp entity.Import, expenses []expenseEntity.Expense) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(
+		"UPDATE imports SET status = ?, committed_at = ? WHERE id = ? AND user_id = ? AND status = ?",
+		string(imp.Status()),
+		nullableString(formatOptionalTimestamp(imp.CommittedAt())),
+		imp.ID(),
+		imp.UserID(),
+		string(entity.ImportPending),
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrImportCommitted, imp.ID())
+	}
+
+	for _, expense := range expenses {
+		if err := insertExpense(tx, expense); err != nil {
+			return err
+		}
+	}
+
+	stmt, err := tx.Prepare("UPDATE import_rows SET status = ?, expense_id = ? WHERE import_id = ? AND line = ?")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, row := range imp.Rows() {
+		if _, err := stmt.Exec(string(row.Status), nullableString(row.ExpenseID), imp.ID(), row.Line); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *ImportsSQLiteRepository) Delete(userID int64, id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM imports WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrImportNotFound, id)
+	}
+
+	if _, err := tx.Exec("DELETE FROM import_rows WHERE import_id = ?", id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
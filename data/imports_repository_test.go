@@ -0,0 +1,129 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportsSQLiteRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewImportsSQLiteRepository(db)
+	expenses := NewExpensesSQLiteRepository(db)
+
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	date := time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	rows := []entity.Row{
+		entity.NewRow(2, date, 1250, "Coffee shop"),
+		entity.InvalidRow(3, `invalid date "yesterday"`),
+	}
+
+	imp, err := entity.NewImport(1, "csv", "april.csv", rows, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*imp))
+
+	found, err := repo.FindByID(1, imp.ID())
+	require.NoError(t, err)
+	assert.Equal(t, imp, found)
+
+	_, err = repo.FindByID(2, imp.ID())
+	assert.ErrorIs(t, err, ErrImportNotFound)
+
+	expense, err := expenseEntity.NewExpense(1, 1250, "Coffee shop", date, expenseEntity.VariableExpense)
+	require.NoError(t, err)
+	require.NoError(t, expense.SetTags([]string{"imported"}))
+
+	found.MarkCommitted(map[int]string{2: expense.ID()}, now)
+	require.NoError(t, repo.Commit(*found, []expenseEntity.Expense{*expense}))
+
+	committed, err := repo.FindByID(1, imp.ID())
+	require.NoError(t, err)
+	assert.Equal(t, entity.ImportCommitted, committed.Status())
+	assert.Equal(t, entity.RowImported, committed.Rows()[0].Status)
+	assert.Equal(t, expense.ID(), committed.Rows()[0].ExpenseID)
+	assert.Equal(t, entity.RowInvalid, committed.Rows()[1].Status)
+
+	saved, err := expenses.FindByID(1, expense.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"imported"}, saved.Tags())
+
+	again, err := expenseEntity.NewExpense(1, 1250, "Coffee shop", date, expenseEntity.VariableExpense)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Commit(*found, []expenseEntity.Expense{*again}), ErrImportCommitted)
+
+	_, err = expenses.FindByID(1, again.ID())
+	assert.ErrorIs(t, err, ErrExpenseNotFound, "A failed commit should not save expenses")
+
+	assert.ErrorIs(t, repo.Delete(2, imp.ID()), ErrImportNotFound)
+	require.NoError(t, repo.Delete(1, imp.ID()))
+	_, err = repo.FindByID(1, imp.ID())
+	assert.ErrorIs(t, err, ErrImportNotFound)
+
+	_, err = expenses.FindByID(1, expense.ID())
+	assert.NoError(t, err, "Deleting an import should keep its expenses")
+}
+
+func TestImportsSQLiteRepository_ExternalIDs(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewImportsSQLiteRepository(db)
+	expenses := NewExpensesSQLiteRepository(db)
+
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	date := time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	row := entity.NewRow(1, date, 3990, "Gym")
+	row.ExternalID = "ofx:42:T1"
+	row.Currency = "USD"
+	row.ExpenseType = expenseEntity.FixedExpense
+
+	newImport := func() *entity.Import {
+		imp, err := entity.NewImport(1, "ofx", "april.ofx", []entity.Row{row}, now)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(*imp))
+		return imp
+	}
+
+	newExpense := func(userID int64) *expenseEntity.Expense {
+		expense, err := expenseEntity.NewExpense(userID, 3990, "Gym", date, expenseEntity.FixedExpense)
+		require.NoError(t, err)
+		expense.SetExternalID(row.ExternalID)
+		return expense
+	}
+
+	first := newImport()
+	found, err := repo.FindByID(1, first.ID())
+	require.NoError(t, err)
+	assert.Equal(t, first, found)
+
+	expense := newExpense(1)
+	found.MarkCommitted(map[int]string{1: expense.ID()}, now)
+	require.NoError(t, repo.Commit(*found, []expenseEntity.Expense{*expense}))
+
+	saved, err := expenses.FindByID(1, expense.ID())
+	require.NoError(t, err)
+	assert.Equal(t, row.ExternalID, saved.ExternalID())
+
+	ids, err := expenses.FindExternalIDs(1, []string{row.ExternalID, "ofx:42:T2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{row.ExternalID: expense.ID()}, ids)
+
+	ids, err = expenses.FindExternalIDs(2, []string{row.ExternalID})
+	require.NoError(t, err)
+	assert.Empty(t, ids, "External IDs are kept per user")
+
+	second := newImport()
+	again := newExpense(1)
+	second.MarkCommitted(map[int]string{1: again.ID()}, now)
+	assert.ErrorIs(t, repo.Commit(*second, []expenseEntity.Expense{*again}), ErrExpenseImported)
+
+	pending, err := repo.FindByID(1, second.ID())
+	require.NoError(t, err)
+	assert.Equal(t, entity.ImportPending, pending.Status(), "A failed commit should leave the import pending")
+
+	require.NoError(t, expenses.Save(*newExpense(2)), "Another user may import the same transaction")
+}
@@ -0,0 +1,211 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/incomes/entity"
+)
+
+type IncomesSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewIncomesSQLiteRepository(db *sql.DB) *IncomesSQLiteRepository {
+	return &IncomesSQLiteRepository{db: db}
+}
+
+const incomeColumns = "id, user_id, amount, currency, source, date, recurring, account_id"
+
+func (r *IncomesSQLiteRepository) FindAll(filter IncomeFilter) ([]entity.Income, error) {
+	incomes := make([]entity.Income, 0)
+
+	where, args := buildIncomeWhere(filter)
+	query := "SELECT " + incomeColumns + " FROM incomes" + where + buildIncomeOrderBy(filter)
+
+	if filter.Limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		income, err := scanIntoIncome(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		incomes = append(incomes, *income)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return incomes, nil
+}
+
+func (r *IncomesSQLiteRepository) Count(filter IncomeFilter) (int64, error) {
+	where, args := buildIncomeWhere(filter)
+
+	var total int64
+	err := r.db.QueryRow("SELECT COUNT(*) FROM incomes"+where, args...).Scan(&total)
+	return total, err
+}
+
+func (r *IncomesSQLiteRepository) Save(income entity.Income) error {
+	_, err := r.db.Exec(
+		"INSERT INTO incomes ("+incomeColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		income.ID(),
+		income.UserID(),
+		income.Amount(),
+		income.Currency(),
+		income.Source(),
+		income.Date().Format("2006-01-02"),
+		income.Recurring(),
+		nullableString(income.AccountID()),
+	)
+
+	return err
+}
+
+func (r *IncomesSQLiteRepository) FindByID(userID int64, id string) (*entity.Income, error) {
+	row := r.db.QueryRow("SELECT "+incomeColumns+" FROM incomes WHERE id = ? AND user_id = ?", id, userID)
+
+	income, err := scanIntoIncome(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrIncomeNotFound, id)
+	}
+
+	return income, err
+}
+
+func (r *IncomesSQLiteRepository) Update(income entity.Income) error {
+	res, err := r.db.Exec(
+		"UPDATE incomes SET amount = ?, currency = ?, source = ?, date = ?, recurring = ?, account_id = ? WHERE id = ? AND user_id = ?",
+		income.Amount(),
+		income.Currency(),
+		income.Source(),
+		income.Date().Format("2006-01-02"),
+		income.Recurring(),
+		nullableString(income.AccountID()),
+		income.ID(),
+		income.UserID(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return expectAffectedIncome(res, income.ID())
+}
+
+func (r *IncomesSQLiteRepository) Delete(userID int64, id string) error {
+	res, err := r.db.Exec("DELETE FROM incomes WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	return expectAffectedIncome(res, id)
+}
+
+func buildIncomeWhere(filter IncomeFilter) (string, []any) {
+	conditions := []string{"user_id = ?"}
+	args := []any{filter.UserID}
+
+	if filter.From != nil {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, filter.From.Format("2006-01-02"))
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, filter.To.Format("2006-01-02"))
+	}
+
+	if filter.Source != "" {
+		conditions = append(conditions, `source LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+likeEscaper.Replace(filter.Source)+"%")
+	}
+
+	if filter.AccountID != "" {
+		conditions = append(conditions, "account_id = ?")
+		args = append(args, filter.AccountID)
+	}
+
+	if filter.Recurring != nil {
+		conditions = append(conditions, "recurring = ?")
+		args = append(args, *filter.Recurring)
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func buildIncomeOrderBy(filter IncomeFilter) string {
+	column := string(IncomeSortByDate)
+	if filter.SortField.IsValid() {
+		column = string(filter.SortField)
+	}
+
+	direction := "ASC"
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+
+	return fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+}
+
+func expectAffectedIncome(res sql.Result, id string) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrIncomeNotFound, id)
+	}
+
+	return nil
+}
+
+func scanIntoIncome(scan func(dest ...any) error) (*entity.Income, error) {
+	var (
+		id        string
+		userID    int64
+		amount    int64
+		currency  string
+		source    string
+		date      string
+		recurring bool
+		accountID sql.NullString
+	)
+
+	if err := scan(&id, &userID, &amount, &currency, &source, &date, &recurring, &accountID); err != nil {
+		return nil, err
+	}
+
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, err
+	}
+
+	income, err := entity.NewIncome(userID, amount, source, parsed, recurring)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := income.SetCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	income.SetID(id)
+	income.SetAccountID(accountID.String)
+
+	return income, nil
+}
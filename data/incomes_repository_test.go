@@ -0,0 +1,99 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/incomes/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncomesSQLiteRepository_CRUD(t *testing.T) {
+	repo := NewIncomesSQLiteRepository(newTestDB(t))
+
+	salary, err := entity.NewIncome(1, 500000, "ACME Corp", testDate(t, "2026-01-30"), true)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*salary))
+
+	found, err := repo.FindByID(1, salary.ID())
+	require.NoError(t, err)
+	assert.Equal(t, salary, found)
+
+	_, err = repo.FindByID(2, salary.ID())
+	assert.ErrorIs(t, err, ErrIncomeNotFound)
+
+	require.NoError(t, found.SetAmount(520000))
+	found.SetRecurring(false)
+	require.NoError(t, repo.Update(*found))
+
+	updated, err := repo.FindByID(1, salary.ID())
+	require.NoError(t, err)
+	assert.Equal(t, int64(520000), updated.Amount())
+	assert.False(t, updated.Recurring())
+
+	forged, err := entity.NewIncome(2, 100, "Hijacked", salary.Date(), false)
+	require.NoError(t, err)
+	forged.SetID(salary.ID())
+	assert.ErrorIs(t, repo.Update(*forged), ErrIncomeNotFound)
+	assert.ErrorIs(t, repo.Delete(2, salary.ID()), ErrIncomeNotFound)
+
+	require.NoError(t, repo.Delete(1, salary.ID()))
+	_, err = repo.FindByID(1, salary.ID())
+	assert.ErrorIs(t, err, ErrIncomeNotFound)
+}
+
+func TestIncomesSQLiteRepository_FindAllFilters(t *testing.T) {
+	repo := NewIncomesSQLiteRepository(newTestDB(t))
+
+	for _, i := range []struct {
+		userID    int64
+		amount    int64
+		source    string
+		date      string
+		recurring bool
+	}{
+		{1, 500000, "ACME Corp", "2026-01-30", true},
+		{1, 75000, "Freelance 100%", "2026-02-10", false},
+		{1, 510000, "ACME Corp", "2026-02-27", true},
+		{2, 1000, "ACME Corp", "2026-02-27", true},
+	} {
+		income, err := entity.NewIncome(i.userID, i.amount, i.source, testDate(t, i.date), i.recurring)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(*income))
+	}
+
+	recurring := true
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		filter   IncomeFilter
+		expected []string
+	}{
+		{"all by date", IncomeFilter{}, []string{"2026-01-30", "2026-02-10", "2026-02-27"}},
+		{"from date", IncomeFilter{From: &from}, []string{"2026-02-10", "2026-02-27"}},
+		{"recurring only", IncomeFilter{Recurring: &recurring}, []string{"2026-01-30", "2026-02-27"}},
+		{"source with LIKE wildcard", IncomeFilter{Source: "100%"}, []string{"2026-02-10"}},
+		{"by amount desc", IncomeFilter{SortField: IncomeSortByAmount, SortDesc: true, Limit: 1}, []string{"2026-02-27"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.filter.UserID = 1
+
+			incomes, err := repo.FindAll(tt.filter)
+			require.NoError(t, err)
+
+			dates := make([]string, 0, len(incomes))
+			for _, i := range incomes {
+				dates = append(dates, i.Date().Format("2006-01-02"))
+			}
+			assert.Equal(t, tt.expected, dates)
+		})
+	}
+
+	total, err := repo.Count(IncomeFilter{UserID: 1, Recurring: &recurring})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
@@ -1,11 +1,436 @@
 package data
 
-import "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+import (
+	"time"
+
+	accountEntity "github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	budgetEntity "github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	categoryEntity "github.com/MarioGN/finance-manager-api/internal/categories/entity"
+	rateEntity "github.com/MarioGN/finance-manager-api/internal/exchangerates/entity"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	importEntity "github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	incomeEntity "github.com/MarioGN/finance-manager-api/internal/incomes/entity"
+	notificationEntity "github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+	recurringEntity "github.com/MarioGN/finance-manager-api/internal/recurring/entity"
+	tagEntity "github.com/MarioGN/finance-manager-api/internal/tags/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var (
+	ErrExpenseNotFound      = errors.NotFound("expense not found")
+	ErrExpenseChanged       = errors.PreconditionFailed("expense was changed since it was read")
+	ErrCategoryNotFound     = errors.NotFound("category not found")
+	ErrCategoryNameTaken    = errors.Conflict("a category with this name already exists at this level")
+	ErrTagNotFound          = errors.NotFound("tag not found")
+	ErrTagNameTaken         = errors.Conflict("a tag with this name already exists")
+	ErrIncomeNotFound       = errors.NotFound("income not found")
+	ErrAccountNotFound      = errors.NotFound("account not found")
+	ErrAccountNameTaken     = errors.Conflict("an account with this name already exists")
+	ErrAccountInUse         = errors.Conflict("account still has expenses, incomes, transfers or recurring rules")
+	ErrTransferNotFound     = errors.NotFound("transfer not found")
+	ErrExchangeRateNotFound = errors.Unprocessable("exchange rate not found")
+	ErrRuleNotFound         = errors.NotFound("recurring rule not found")
+	ErrOccurrenceTaken      = errors.Conflict("occurrence was already created or skipped")
+	ErrBudgetNotFound       = errors.NotFound("budget not found")
+	ErrBudgetExists         = errors.Conflict("a budget for this month and category or type already exists")
+	ErrAlertRaised          = errors.Conflict("budget alert was already raised")
+	ErrWebhookNotFound      = errors.NotFound("webhook not found")
+	ErrImportNotFound       = errors.NotFound("import not found")
+	ErrImportCommitted      = errors.Conflict("import was already committed")
+	ErrExpenseImported      = errors.Conflict("transaction was already imported")
+)
+
+type ExpenseSortField string
+
+const (
+	SortByDate        ExpenseSortField = "date"
+	SortByAmount      ExpenseSortField = "amount"
+	SortByDescription ExpenseSortField = "description"
+	SortByExpenseType ExpenseSortField = "expense_type"
+	// SortByDeletedAt only orders expenses in the trash, so it is not one of
+	// the valid fields of the expense list.
+	SortByDeletedAt ExpenseSortField = "deleted_at"
+)
+
+func (f ExpenseSortField) IsValid() bool {
+	switch f {
+	case SortByDate, SortByAmount, SortByDescription, SortByExpenseType:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExpenseFilter narrows FindAll and Count to a single user's expenses.
+// Nil pointers and empty values are ignored; Limit 0 means no limit.
+// AnyTags matches expenses with at least one of the tags and AllTags those
+// with every tag; tag names must be distinct. Trashed selects the expenses
+// in the trash instead of the others.
+type ExpenseFilter struct {
+	UserID      int64
+	Trashed     bool
+	From        *time.Time
+	To          *time.Time
+	ExpenseType entity.ExpenseType
+	CategoryID  string
+	AccountID   string
+	AnyTags     []string
+	AllTags     []string
+	AmountMin   *int64
+	AmountMax   *int64
+	Description string
+	SortField   ExpenseSortField
+	SortDesc    bool
+	Limit       int
+	Offset      int
+}
 
 type ExpenseRepository interface {
-	FindAll() ([]entity.Expense, error)
+	FindAll(filter ExpenseFilter) ([]entity.Expense, error)
+	// Stream calls fn with each expense matching filter without loading
+	// them all at once. It stops at the first error fn returns.
+	Stream(filter ExpenseFilter, fn func(expense entity.Expense) error) error
+	Count(filter ExpenseFilter) (int64, error)
 	Save(expense entity.Expense) error
-	FindByID(id string) (*entity.Expense, error)
+	FindByID(userID int64, id string) (*entity.Expense, error)
+	// Update saves the expense if it still has the version it was read with,
+	// and increments the version.
 	Update(expense entity.Expense) error
-	Delete(id string) error
+	// Trash moves the expense to the trash at its DeletedAt if it still has
+	// the version it was read with, and increments the version. Only
+	// FindAll, Stream and Count with Trashed set find it there.
+	Trash(expense entity.Expense) error
+	// Restore takes one of the user's expenses out of the trash.
+	Restore(userID int64, id string) error
+	// Purge deletes every user's expenses moved to the trash before the
+	// given time for good, and returns how many there were.
+	Purge(before time.Time) (int64, error)
+	// FindExternalIDs returns, for each of externalIDs already recorded on
+	// one of the user's expenses, the ID of that expense. Expenses in the
+	// trash count, so that a trashed transaction is not imported again.
+	FindExternalIDs(userID int64, externalIDs []string) (map[string]string, error)
+}
+
+type IncomeSortField string
+
+const (
+	IncomeSortByDate   IncomeSortField = "date"
+	IncomeSortByAmount IncomeSortField = "amount"
+	IncomeSortBySource IncomeSortField = "source"
+)
+
+func (f IncomeSortField) IsValid() bool {
+	switch f {
+	case IncomeSortByDate, IncomeSortByAmount, IncomeSortBySource:
+		return true
+	default:
+		return false
+	}
+}
+
+// IncomeFilter narrows FindAll and Count to a single user's incomes.
+// Nil pointers and empty values are ignored; Limit 0 means no limit.
+type IncomeFilter struct {
+	UserID    int64
+	From      *time.Time
+	To        *time.Time
+	Source    string
+	AccountID string
+	Recurring *bool
+	SortField IncomeSortField
+	SortDesc  bool
+	Limit     int
+	Offset    int
+}
+
+type IncomeRepository interface {
+	FindAll(filter IncomeFilter) ([]incomeEntity.Income, error)
+	Count(filter IncomeFilter) (int64, error)
+	Save(income incomeEntity.Income) error
+	FindByID(userID int64, id string) (*incomeEntity.Income, error)
+	Update(income incomeEntity.Income) error
+	Delete(userID int64, id string) error
+}
+
+// AccountBalance is an account together with its current balance in cents.
+type AccountBalance struct {
+	Account accountEntity.Account
+	Balance int64
+}
+
+type LedgerEntryKind string
+
+const (
+	LedgerIncome      LedgerEntryKind = "income"
+	LedgerExpense     LedgerEntryKind = "expense"
+	LedgerTransferIn  LedgerEntryKind = "transfer_in"
+	LedgerTransferOut LedgerEntryKind = "transfer_out"
+)
+
+type LedgerFilter struct {
+	UserID    int64
+	AccountID string
+	From      *time.Time
+	To        *time.Time
+}
+
+// LedgerEntry is a movement on an account in cents. Amount is negative for
+// money leaving the account and Balance is the running balance after it,
+// opening balance included.
+type LedgerEntry struct {
+	ID          string
+	Date        time.Time
+	Kind        LedgerEntryKind
+	Description string
+	Amount      int64
+	Balance     int64
+}
+
+type AccountRepository interface {
+	FindAll(userID int64) ([]AccountBalance, error)
+	FindByID(userID int64, id string) (*accountEntity.Account, error)
+	// Balance returns the balance of the account before the given date, or
+	// its current balance when before is nil.
+	Balance(userID int64, id string, before *time.Time) (int64, error)
+	Ledger(filter LedgerFilter) ([]LedgerEntry, error)
+	Save(account accountEntity.Account) error
+	Update(account accountEntity.Account) error
+	// Delete fails with ErrAccountInUse while anything still references the
+	// account.
+	Delete(userID int64, id string) error
+}
+
+type TransferFilter struct {
+	UserID    int64
+	AccountID string
+	From      *time.Time
+	To        *time.Time
+}
+
+type TransferRepository interface {
+	FindAll(filter TransferFilter) ([]accountEntity.Transfer, error)
+	FindByID(userID int64, id string) (*accountEntity.Transfer, error)
+	Save(transfer accountEntity.Transfer) error
+	Delete(userID int64, id string) error
+}
+
+type ExchangeRateFilter struct {
+	UserID int64
+	Base   string
+	Quote  string
+	From   *time.Time
+	To     *time.Time
+}
+
+type ExchangeRateRepository interface {
+	FindAll(filter ExchangeRateFilter) ([]rateEntity.ExchangeRate, error)
+	// Latest returns the most recent rate for the pair on or before date.
+	Latest(userID int64, base, quote string, date time.Time) (*rateEntity.ExchangeRate, error)
+	// Bases lists the currencies the user has rates from, used to find a
+	// common currency for cross rates.
+	Bases(userID int64) ([]string, error)
+	// Save stores the rates in one transaction, replacing any existing rate
+	// for the same pair and date.
+	Save(rates []rateEntity.ExchangeRate) error
+}
+
+// CurrencyConverter converts an amount in cents recorded in currency on
+// date into the report currency.
+type CurrencyConverter interface {
+	Convert(amount int64, currency string, date time.Time) (int64, error)
+}
+
+type OccurrenceStatus string
+
+const (
+	// OccurrenceCreated marks a date whose expense was created, or is being
+	// created, from the rule.
+	OccurrenceCreated OccurrenceStatus = "created"
+	OccurrenceSkipped OccurrenceStatus = "skipped"
+)
+
+type Occurrence struct {
+	RuleID    string
+	Date      time.Time
+	Status    OccurrenceStatus
+	ExpenseID string
+}
+
+type RecurringRuleRepository interface {
+	FindAll(userID int64) ([]recurringEntity.Rule, error)
+	FindByID(userID int64, id string) (*recurringEntity.Rule, error)
+	// FindDue returns the rules of every user that are not paused and may
+	// have occurrences on or before date left to create.
+	FindDue(date time.Time) ([]recurringEntity.Rule, error)
+	Save(rule recurringEntity.Rule) error
+	Update(rule recurringEntity.Rule) error
+	// Delete removes the rule and its occurrence records. Expenses already
+	// created from it are kept.
+	Delete(userID int64, id string) error
+	// SetGeneratedThrough records that every occurrence up to date has been
+	// handled, without touching the rest of the rule.
+	SetGeneratedThrough(ruleID string, date time.Time) error
+
+	Occurrences(ruleID string, from, to time.Time) ([]Occurrence, error)
+	// ClaimOccurrence records the occurrence unless the date already has
+	// one, in which case it returns ErrOccurrenceTaken. Claiming before the
+	// expense is created keeps a date from being created twice.
+	ClaimOccurrence(occurrence Occurrence) error
+	SetOccurrenceExpense(ruleID string, date time.Time, expenseID string) error
+	ReleaseOccurrence(ruleID string, date time.Time) error
+}
+
+// BudgetAlert records that a budget reached Threshold percent in Month.
+type BudgetAlert struct {
+	BudgetID  string
+	Month     time.Time
+	Threshold int
+}
+
+type BudgetRepository interface {
+	FindAll(userID int64) ([]budgetEntity.Budget, error)
+	FindByID(userID int64, id string) (*budgetEntity.Budget, error)
+	Save(budget budgetEntity.Budget) error
+	Update(budget budgetEntity.Budget) error
+	Delete(userID int64, id string) error
+	// ClaimAlert records the alert, or fails with ErrAlertRaised when it was
+	// already recorded.
+	ClaimAlert(alert BudgetAlert) error
+	ReleaseAlert(alert BudgetAlert) error
+}
+
+type DeliveryFilter struct {
+	UserID    int64
+	WebhookID string
+	Status    notificationEntity.DeliveryStatus
+	Limit     int
+	Offset    int
+}
+
+type WebhookRepository interface {
+	FindAll(userID int64) ([]notificationEntity.Webhook, error)
+	FindByID(userID int64, id string) (*notificationEntity.Webhook, error)
+	Save(webhook notificationEntity.Webhook) error
+	Update(webhook notificationEntity.Webhook) error
+	// Delete removes the webhook along with its deliveries.
+	Delete(userID int64, id string) error
+	SaveDelivery(delivery notificationEntity.Delivery) error
+	UpdateDelivery(delivery notificationEntity.Delivery) error
+	FindDeliveries(filter DeliveryFilter) ([]notificationEntity.Delivery, error)
+	CountDeliveries(filter DeliveryFilter) (int64, error)
+	// FindDueDeliveries returns up to limit pending deliveries whose next
+	// attempt is at or before now, oldest first.
+	FindDueDeliveries(now time.Time, limit int) ([]notificationEntity.Delivery, error)
+}
+
+type ImportRepository interface {
+	FindByID(userID int64, id string) (*importEntity.Import, error)
+	// Save stores a pending import with its rows.
+	Save(imp importEntity.Import) error
+	// Commit saves the expenses and the committed import in one transaction.
+	// It fails with ErrImportCommitted when the import was committed
+	// concurrently.
+	Commit(imp importEntity.Import, expenses []entity.Expense) error
+	Delete(userID int64, id string) error
+}
+
+type CategoryRepository interface {
+	FindAll(userID int64) ([]categoryEntity.Category, error)
+	FindByID(userID int64, id string) (*categoryEntity.Category, error)
+	Save(category categoryEntity.Category) error
+	Update(category categoryEntity.Category) error
+	Delete(userID int64, id string) error
+}
+
+// TagUsage is a tag together with the number of expenses carrying it.
+type TagUsage struct {
+	Tag        tagEntity.Tag
+	UsageCount int64
+}
+
+// TagRepository manages a user's tags. Tags are created implicitly when an
+// expense is saved with them.
+type TagRepository interface {
+	FindAll(userID int64) ([]TagUsage, error)
+	FindByID(userID int64, id string) (*tagEntity.Tag, error)
+	Update(tag tagEntity.Tag) error
+	Merge(userID int64, sourceID, targetID string) error
+	Delete(userID int64, id string) error
+}
+
+type SummaryGrouping string
+
+const (
+	GroupByMonth SummaryGrouping = "month"
+	GroupByWeek  SummaryGrouping = "week"
+	GroupByType  SummaryGrouping = "type"
+)
+
+func (g SummaryGrouping) IsValid() bool {
+	switch g {
+	case GroupByMonth, GroupByWeek, GroupByType:
+		return true
+	default:
+		return false
+	}
+}
+
+// SummaryFilter selects what the report repository aggregates. Amounts are
+// passed through Converter when set and summed as stored otherwise.
+type SummaryFilter struct {
+	UserID    int64
+	From      *time.Time
+	To        *time.Time
+	GroupBy   SummaryGrouping
+	Converter CurrencyConverter
+}
+
+// SummaryRow holds aggregated amounts in cents. Key is empty for the
+// overall totals row.
+type SummaryRow struct {
+	Key     string
+	Total   int64
+	Count   int64
+	Average int64
+}
+
+type ExpenseSummary struct {
+	Totals SummaryRow
+	Groups []SummaryRow
+}
+
+// CashflowRow holds income and expense totals in cents. Key is empty for
+// the overall totals row.
+type CashflowRow struct {
+	Key      string
+	Income   int64
+	Expenses int64
+}
+
+func (r CashflowRow) Net() int64 {
+	return r.Income - r.Expenses
+}
+
+type Cashflow struct {
+	Totals  CashflowRow
+	Periods []CashflowRow
+}
+
+// SpendingFilter selects the expenses SumExpenses adds up. A category
+// includes its subcategories. Amounts are passed through Converter when set.
+type SpendingFilter struct {
+	UserID      int64
+	From        time.Time
+	To          time.Time
+	CategoryID  string
+	ExpenseType entity.ExpenseType
+	Converter   CurrencyConverter
+}
+
+type ReportRepository interface {
+	SummarizeExpenses(filter SummaryFilter) (*ExpenseSummary, error)
+	SumExpenses(filter SpendingFilter) (int64, error)
+	// SummarizeCashflow nets incomes against expenses per period. Only the
+	// month and week groupings apply.
+	SummarizeCashflow(filter SummaryFilter) (*Cashflow, error)
 }
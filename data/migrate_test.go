@@ -0,0 +1,127 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator_UpDownStatus(t *testing.T) {
+	db := openTestDB(t)
+
+	migrator, err := NewMigrator(db)
+	require.NoError(t, err)
+	require.NotEmpty(t, migrator.migrations)
+
+	statuses, err := migrator.Status()
+	require.NoError(t, err)
+	for _, s := range statuses {
+		assert.Nil(t, s.AppliedAt, "Migration %d should be pending on a fresh database", s.Version)
+	}
+
+	applied, err := migrator.Up()
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrator.migrations))
+
+	applied, err = migrator.Up()
+	require.NoError(t, err)
+	assert.Empty(t, applied, "Up should be a no-op when nothing is pending")
+
+	statuses, err = migrator.Status()
+	require.NoError(t, err)
+	for _, s := range statuses {
+		assert.NotNil(t, s.AppliedAt, "Migration %d should be applied", s.Version)
+	}
+
+	latest := migrator.migrations[len(migrator.migrations)-1]
+	rolledBack, err := migrator.Down(1)
+	require.NoError(t, err)
+	require.Len(t, rolledBack, 1)
+	assert.Equal(t, latest.Version, rolledBack[0].Version)
+
+	rolledBack, err = migrator.Down(len(migrator.migrations))
+	require.NoError(t, err)
+	assert.Len(t, rolledBack, len(migrator.migrations)-1)
+
+	exists, err := tableExists(db, "expenses")
+	require.NoError(t, err)
+	assert.False(t, exists, "Rolling everything back should drop the schema")
+
+	applied, err = migrator.Up()
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrator.migrations), "Migrations should be re-applicable after a full rollback")
+}
+
+func TestMigrator_FailedMigrationIsRolledBack(t *testing.T) {
+	db := openTestDB(t)
+
+	migrator, err := NewMigrator(db)
+	require.NoError(t, err)
+
+	migrator.migrations = append(migrator.migrations, Migration{
+		Version: 9999,
+		Name:    "broken",
+		up:      "CREATE TABLE half_done (id INTEGER); SELECT * FROM missing_table;",
+		down:    "DROP TABLE half_done;",
+	})
+
+	_, err = migrator.Up()
+	require.Error(t, err)
+
+	exists, err := tableExists(db, "half_done")
+	require.NoError(t, err)
+	assert.False(t, exists, "Partial changes of a failed migration should be rolled back")
+
+	statuses, err := migrator.Status()
+	require.NoError(t, err)
+	assert.Nil(t, statuses[len(statuses)-1].AppliedAt)
+}
+
+func TestMigrator_UpgradesLegacyDatabase(t *testing.T) {
+	db := openTestDB(t)
+
+	_, err := db.Exec(`
+	CREATE TABLE expenses (
+		id TEXT PRIMARY KEY,
+		amount REAL NOT NULL,
+		description TEXT,
+		date TEXT NOT NULL,
+		expense_type TEXT NOT NULL
+	);
+	INSERT INTO expenses VALUES ('a', 12.34, 'Lunch', '2026-01-02', 'variable');
+	INSERT INTO expenses VALUES ('b', 0.29, 'Gum', '2026-01-03', 'unplanned');
+	`)
+	require.NoError(t, err)
+
+	migrator, err := NewMigrator(db)
+	require.NoError(t, err)
+
+	applied, err := migrator.Up()
+	require.NoError(t, err)
+	require.NotEmpty(t, applied)
+	assert.Equal(t, 2, applied[0].Version, "The legacy expenses table should be adopted as version 1")
+
+	amountType, err := columnType(db, "expenses", "amount")
+	require.NoError(t, err)
+	assert.Equal(t, "INTEGER", amountType)
+
+	rows, err := db.Query("SELECT id, user_id, amount FROM expenses ORDER BY id")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	got := map[string]int64{}
+	for rows.Next() {
+		var (
+			id     string
+			userID int64
+			amount int64
+		)
+		require.NoError(t, rows.Scan(&id, &userID, &amount))
+		assert.Zero(t, userID, "Legacy rows should not be assigned to any user")
+		got[id] = amount
+	}
+	require.NoError(t, rows.Err())
+
+	assert.Equal(t, map[string]int64{"a": 1234, "b": 29}, got)
+}
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS expenses (
+	id TEXT PRIMARY KEY,
+	amount REAL NOT NULL,
+	description TEXT,
+	date TEXT NOT NULL,
+	expense_type TEXT NOT NULL
+);
@@ -0,0 +1,2 @@
+DROP INDEX idx_expenses_user_date;
+ALTER TABLE expenses DROP COLUMN user_id;
@@ -0,0 +1,5 @@
+-- Rows created before expenses had owners are kept under user 0, which no
+-- authenticated user can ever match.
+ALTER TABLE expenses ADD COLUMN user_id INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_expenses_user_date ON expenses (user_id, date);
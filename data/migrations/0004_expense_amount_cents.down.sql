@@ -0,0 +1,16 @@
+CREATE TABLE expenses_real (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL DEFAULT 0,
+	amount REAL NOT NULL,
+	description TEXT,
+	date TEXT NOT NULL,
+	expense_type TEXT NOT NULL
+);
+
+INSERT INTO expenses_real (id, user_id, amount, description, date, expense_type)
+	SELECT id, user_id, amount / 100.0, description, date, expense_type FROM expenses;
+
+DROP TABLE expenses;
+ALTER TABLE expenses_real RENAME TO expenses;
+
+CREATE INDEX idx_expenses_user_date ON expenses (user_id, date);
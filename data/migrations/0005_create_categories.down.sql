@@ -0,0 +1,5 @@
+DROP INDEX idx_expenses_category;
+ALTER TABLE expenses DROP COLUMN category_id;
+
+DROP INDEX idx_categories_user_parent_name;
+DROP TABLE categories;
@@ -0,0 +1,16 @@
+-- References to categories are maintained by the repositories rather than
+-- foreign keys, which SQLite only enforces per connection.
+CREATE TABLE categories (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	name TEXT NOT NULL,
+	color TEXT NOT NULL DEFAULT '',
+	icon TEXT NOT NULL DEFAULT '',
+	parent_id TEXT
+);
+
+CREATE UNIQUE INDEX idx_categories_user_parent_name ON categories (user_id, COALESCE(parent_id, ''), name COLLATE NOCASE);
+
+ALTER TABLE expenses ADD COLUMN category_id TEXT;
+
+CREATE INDEX idx_expenses_category ON expenses (category_id);
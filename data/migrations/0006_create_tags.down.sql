@@ -0,0 +1,5 @@
+DROP INDEX idx_expense_tags_tag;
+DROP TABLE expense_tags;
+
+DROP INDEX idx_tags_user_name;
+DROP TABLE tags;
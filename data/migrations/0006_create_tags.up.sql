@@ -0,0 +1,15 @@
+CREATE TABLE tags (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	name TEXT NOT NULL COLLATE NOCASE
+);
+
+CREATE UNIQUE INDEX idx_tags_user_name ON tags (user_id, name);
+
+CREATE TABLE expense_tags (
+	expense_id TEXT NOT NULL,
+	tag_id TEXT NOT NULL,
+	PRIMARY KEY (expense_id, tag_id)
+);
+
+CREATE INDEX idx_expense_tags_tag ON expense_tags (tag_id);
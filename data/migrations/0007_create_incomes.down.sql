@@ -0,0 +1,2 @@
+DROP INDEX idx_incomes_user_date;
+DROP TABLE incomes;
@@ -0,0 +1,10 @@
+CREATE TABLE incomes (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	amount INTEGER NOT NULL,
+	source TEXT NOT NULL,
+	date TEXT NOT NULL,
+	recurring INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_incomes_user_date ON incomes (user_id, date);
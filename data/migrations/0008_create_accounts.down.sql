@@ -0,0 +1,12 @@
+DROP INDEX idx_incomes_account;
+ALTER TABLE incomes DROP COLUMN account_id;
+
+DROP INDEX idx_expenses_account;
+ALTER TABLE expenses DROP COLUMN account_id;
+
+DROP INDEX idx_transfers_to_account;
+DROP INDEX idx_transfers_from_account;
+DROP TABLE transfers;
+
+DROP INDEX idx_accounts_user_name;
+DROP TABLE accounts;
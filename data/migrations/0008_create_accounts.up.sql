@@ -0,0 +1,31 @@
+-- References to accounts are maintained by the repositories rather than
+-- foreign keys, which SQLite only enforces per connection.
+CREATE TABLE accounts (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	name TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	currency TEXT NOT NULL,
+	opening_balance INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX idx_accounts_user_name ON accounts (user_id, name COLLATE NOCASE);
+
+CREATE TABLE transfers (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	from_account_id TEXT NOT NULL,
+	to_account_id TEXT NOT NULL,
+	amount INTEGER NOT NULL,
+	date TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_transfers_from_account ON transfers (from_account_id, date);
+CREATE INDEX idx_transfers_to_account ON transfers (to_account_id, date);
+
+ALTER TABLE expenses ADD COLUMN account_id TEXT;
+CREATE INDEX idx_expenses_account ON expenses (account_id, date);
+
+ALTER TABLE incomes ADD COLUMN account_id TEXT;
+CREATE INDEX idx_incomes_account ON incomes (account_id, date);
@@ -0,0 +1,5 @@
+DROP TABLE exchange_rates;
+
+ALTER TABLE users DROP COLUMN base_currency;
+ALTER TABLE incomes DROP COLUMN currency;
+ALTER TABLE expenses DROP COLUMN currency;
@@ -0,0 +1,22 @@
+-- Amounts recorded so far are assumed to be in EUR, except where they were
+-- booked on an account, which already carries a currency.
+ALTER TABLE expenses ADD COLUMN currency TEXT NOT NULL DEFAULT 'EUR';
+UPDATE expenses SET currency = (SELECT currency FROM accounts WHERE accounts.id = expenses.account_id)
+	WHERE account_id IN (SELECT id FROM accounts);
+
+ALTER TABLE incomes ADD COLUMN currency TEXT NOT NULL DEFAULT 'EUR';
+UPDATE incomes SET currency = (SELECT currency FROM accounts WHERE accounts.id = incomes.account_id)
+	WHERE account_id IN (SELECT id FROM accounts);
+
+ALTER TABLE users ADD COLUMN base_currency TEXT NOT NULL DEFAULT 'EUR';
+
+-- Rates are kept as decimal text so that they round-trip exactly. A rate
+-- says how many units of quote one unit of base buys on that date.
+CREATE TABLE exchange_rates (
+	user_id INTEGER NOT NULL,
+	base TEXT NOT NULL,
+	quote TEXT NOT NULL,
+	date TEXT NOT NULL,
+	rate TEXT NOT NULL,
+	PRIMARY KEY (user_id, base, quote, date)
+);
@@ -0,0 +1,4 @@
+DROP TABLE recurring_occurrences;
+
+DROP INDEX idx_recurring_rules_user;
+DROP TABLE recurring_rules;
@@ -0,0 +1,31 @@
+CREATE TABLE recurring_rules (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	amount INTEGER NOT NULL,
+	currency TEXT NOT NULL DEFAULT '',
+	description TEXT NOT NULL DEFAULT '',
+	expense_type TEXT NOT NULL,
+	category_id TEXT,
+	account_id TEXT,
+	tags TEXT NOT NULL DEFAULT '',
+	frequency TEXT NOT NULL,
+	interval INTEGER NOT NULL DEFAULT 1,
+	day_of_month INTEGER NOT NULL DEFAULT 0,
+	start_date TEXT NOT NULL,
+	end_date TEXT,
+	count INTEGER NOT NULL DEFAULT 0,
+	paused INTEGER NOT NULL DEFAULT 0,
+	generated_through TEXT
+);
+
+CREATE INDEX idx_recurring_rules_user ON recurring_rules (user_id);
+
+-- One row per rule and date that was either turned into an expense or
+-- skipped. The primary key is what keeps the scheduler idempotent.
+CREATE TABLE recurring_occurrences (
+	rule_id TEXT NOT NULL,
+	date TEXT NOT NULL,
+	status TEXT NOT NULL,
+	expense_id TEXT,
+	PRIMARY KEY (rule_id, date)
+);
@@ -0,0 +1,2 @@
+DROP INDEX idx_budgets_scope;
+DROP TABLE budgets;
@@ -0,0 +1,13 @@
+-- month is the first day of the month a budget is for, or NULL for a
+-- budget that applies to every month.
+CREATE TABLE budgets (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	month TEXT,
+	category_id TEXT,
+	expense_type TEXT,
+	amount INTEGER NOT NULL,
+	currency TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_budgets_scope ON budgets (user_id, IFNULL(month, ''), IFNULL(category_id, ''), IFNULL(expense_type, ''));
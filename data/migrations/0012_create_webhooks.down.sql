@@ -0,0 +1,6 @@
+DROP TABLE budget_alerts;
+DROP INDEX idx_webhook_deliveries_due;
+DROP INDEX idx_webhook_deliveries_webhook;
+DROP TABLE webhook_deliveries;
+DROP INDEX idx_webhooks_user;
+DROP TABLE webhooks;
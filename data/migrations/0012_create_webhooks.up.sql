@@ -0,0 +1,37 @@
+CREATE TABLE webhooks (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	url TEXT NOT NULL,
+	secret TEXT NOT NULL,
+	active BOOLEAN NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_webhooks_user ON webhooks (user_id);
+
+-- Timestamps are RFC 3339 in UTC so that they sort as text.
+CREATE TABLE webhook_deliveries (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	webhook_id TEXT NOT NULL,
+	event TEXT NOT NULL,
+	payload TEXT NOT NULL,
+	status TEXT NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	last_status_code INTEGER NOT NULL DEFAULT 0,
+	last_error TEXT NOT NULL DEFAULT '',
+	created_at TEXT NOT NULL,
+	next_attempt_at TEXT,
+	delivered_at TEXT
+);
+
+CREATE INDEX idx_webhook_deliveries_webhook ON webhook_deliveries (webhook_id, created_at);
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
+
+-- One row per budget threshold already reached in a month, so that each
+-- alert is only sent once.
+CREATE TABLE budget_alerts (
+	budget_id TEXT NOT NULL,
+	month TEXT NOT NULL,
+	threshold INTEGER NOT NULL,
+	PRIMARY KEY (budget_id, month, threshold)
+);
@@ -0,0 +1,3 @@
+DROP TABLE import_rows;
+DROP INDEX idx_imports_user;
+DROP TABLE imports;
@@ -0,0 +1,24 @@
+CREATE TABLE imports (
+	id TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL,
+	source TEXT NOT NULL,
+	filename TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL,
+	created_at TEXT NOT NULL,
+	committed_at TEXT
+);
+
+CREATE INDEX idx_imports_user ON imports (user_id, created_at);
+
+CREATE TABLE import_rows (
+	import_id TEXT NOT NULL,
+	line INTEGER NOT NULL,
+	date TEXT,
+	amount INTEGER NOT NULL DEFAULT 0,
+	description TEXT NOT NULL DEFAULT '',
+	hash TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL,
+	error TEXT NOT NULL DEFAULT '',
+	expense_id TEXT,
+	PRIMARY KEY (import_id, line)
+);
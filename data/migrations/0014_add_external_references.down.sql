@@ -0,0 +1,6 @@
+ALTER TABLE import_rows DROP COLUMN expense_type;
+ALTER TABLE import_rows DROP COLUMN currency;
+ALTER TABLE import_rows DROP COLUMN external_id;
+
+DROP INDEX idx_expenses_external_id;
+ALTER TABLE expenses DROP COLUMN external_id;
@@ -0,0 +1,10 @@
+-- The bank's own reference for a transaction, such as an OFX FITID or a
+-- CAMT.053 entry reference, so that a statement can be imported twice
+-- without recording its transactions twice.
+ALTER TABLE expenses ADD COLUMN external_id TEXT;
+
+CREATE UNIQUE INDEX idx_expenses_external_id ON expenses (user_id, external_id) WHERE external_id IS NOT NULL;
+
+ALTER TABLE import_rows ADD COLUMN external_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE import_rows ADD COLUMN currency TEXT NOT NULL DEFAULT '';
+ALTER TABLE import_rows ADD COLUMN expense_type TEXT NOT NULL DEFAULT '';
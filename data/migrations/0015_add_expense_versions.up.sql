@@ -0,0 +1,3 @@
+-- Incremented on every change, so that an update or delete made on an
+-- expense read before someone else changed it can be refused.
+ALTER TABLE expenses ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,5 @@
+DELETE FROM expense_tags WHERE expense_id IN (SELECT id FROM expenses WHERE deleted_at IS NOT NULL);
+DELETE FROM expenses WHERE deleted_at IS NOT NULL;
+
+DROP INDEX idx_expenses_deleted_at;
+ALTER TABLE expenses DROP COLUMN deleted_at;
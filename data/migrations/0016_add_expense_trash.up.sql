@@ -0,0 +1,6 @@
+-- When the expense was moved to the trash, or NULL while it is not. Trashed
+-- expenses are left out of every list, total and balance until they are
+-- restored or purged.
+ALTER TABLE expenses ADD COLUMN deleted_at TEXT;
+
+CREATE INDEX idx_expenses_deleted_at ON expenses (deleted_at) WHERE deleted_at IS NOT NULL;
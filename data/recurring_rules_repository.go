@@ -0,0 +1,325 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/entity"
+)
+
+type RecurringRulesSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewRecurringRulesSQLiteRepository(db *sql.DB) *RecurringRulesSQLiteRepository {
+	return &RecurringRulesSQLiteRepository{db: db}
+}
+
+const recurringRuleColumns = "id, user_id, amount, currency, description, expense_type, category_id, account_id, tags, " +
+	"frequency, interval, day_of_month, start_date, end_date, count, paused, generated_through"
+
+func (r *RecurringRulesSQLiteRepository) FindAll(userID int64) ([]entity.Rule, error) {
+	return r.queryRules("SELECT "+recurringRuleColumns+" FROM recurring_rules WHERE user_id = ? ORDER BY start_date, id", userID)
+}
+
+func (r *RecurringRulesSQLiteRepository) FindByID(userID int64, id string) (*entity.Rule, error) {
+	row := r.db.QueryRow("SELECT "+recurringRuleColumns+" FROM recurring_rules WHERE id = ? AND user_id = ?", id, userID)
+
+	rule, err := scanIntoRecurringRule(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrRuleNotFound, id)
+	}
+
+	return rule, err
+}
+
+func (r *RecurringRulesSQLiteRepository) FindDue(date time.Time) ([]entity.Rule, error) {
+	day := date.Format("2006-01-02")
+
+	return r.queryRules(
+		"SELECT "+recurringRuleColumns+" FROM recurring_rules"+
+			" WHERE paused = 0 AND start_date <= ?1 AND (generated_through IS NULL OR generated_through < ?1)"+
+			" AND (end_date IS NULL OR generated_through IS NULL OR generated_through < end_date)"+
+			" ORDER BY user_id, id",
+		day,
+	)
+}
+
+func (r *RecurringRulesSQLiteRepository) Save(rule entity.Rule) error {
+	_, err := r.db.Exec(
+		"INSERT INTO recurring_rules ("+recurringRuleColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		recurringRuleValues(rule)...,
+	)
+
+	return err
+}
+
+func (r *RecurringRulesSQLiteRepository) Update(rule entity.Rule) error {
+	// recurringRuleValues starts with the id and user id, which go last here.
+	values := recurringRuleValues(rule)
+
+	res, err := r.db.Exec(
+		`UPDATE recurring_rules SET amount = ?, currency = ?, description = ?, expense_type = ?, category_id = ?, account_id = ?, tags = ?,
+			frequency = ?, interval = ?, day_of_month = ?, start_date = ?, end_date = ?, count = ?, paused = ?, generated_through = ?
+		WHERE id = ? AND user_id = ?`,
+		append(values[2:], values[0], values[1])...,
+	)
+	if err != nil {
+		return err
+	}
+
+	return expectAffectedRule(res, rule.ID())
+}
+
+func (r *RecurringRulesSQLiteRepository) Delete(userID int64, id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM recurring_rules WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := expectAffectedRule(res, id); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM recurring_occurrences WHERE rule_id = ?", id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *RecurringRulesSQLiteRepository) SetGeneratedThrough(ruleID string, date time.Time) error {
+	res, err := r.db.Exec("UPDATE recurring_rules SET generated_through = ? WHERE id = ?", date.Format("2006-01-02"), ruleID)
+	if err != nil {
+		return err
+	}
+
+	return expectAffectedRule(res, ruleID)
+}
+
+func (r *RecurringRulesSQLiteRepository) Occurrences(ruleID string, from, to time.Time) ([]Occurrence, error) {
+	rows, err := r.db.Query(
+		"SELECT rule_id, date, status, expense_id FROM recurring_occurrences WHERE rule_id = ? AND date >= ? AND date <= ? ORDER BY date",
+		ruleID, from.Format("2006-01-02"), to.Format("2006-01-02"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	occurrences := make([]Occurrence, 0)
+
+	for rows.Next() {
+		var (
+			occurrence Occurrence
+			date       string
+			expenseID  sql.NullString
+		)
+
+		if err := rows.Scan(&occurrence.RuleID, &date, &occurrence.Status, &expenseID); err != nil {
+			return nil, err
+		}
+
+		if occurrence.Date, err = time.Parse("2006-01-02", date); err != nil {
+			return nil, err
+		}
+
+		occurrence.ExpenseID = expenseID.String
+		occurrences = append(occurrences, occurrence)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return occurrences, nil
+}
+
+func (r *RecurringRulesSQLiteRepository) ClaimOccurrence(occurrence Occurrence) error {
+	res, err := r.db.Exec(
+		"INSERT INTO recurring_occurrences (rule_id, date, status, expense_id) VALUES (?, ?, ?, ?) ON CONFLICT DO NOTHING",
+		occurrence.RuleID,
+		occurrence.Date.Format("2006-01-02"),
+		string(occurrence.Status),
+		nullableString(occurrence.ExpenseID),
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrOccurrenceTaken, occurrence.Date.Format("2006-01-02"))
+	}
+
+	return nil
+}
+
+func (r *RecurringRulesSQLiteRepository) SetOccurrenceExpense(ruleID string, date time.Time, expenseID string) error {
+	_, err := r.db.Exec(
+		"UPDATE recurring_occurrences SET expense_id = ? WHERE rule_id = ? AND date = ?",
+		expenseID, ruleID, date.Format("2006-01-02"),
+	)
+
+	return err
+}
+
+func (r *RecurringRulesSQLiteRepository) ReleaseOccurrence(ruleID string, date time.Time) error {
+	_, err := r.db.Exec("DELETE FROM recurring_occurrences WHERE rule_id = ? AND date = ?", ruleID, date.Format("2006-01-02"))
+
+	return err
+}
+
+func (r *RecurringRulesSQLiteRepository) queryRules(query string, args ...any) ([]entity.Rule, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]entity.Rule, 0)
+
+	for rows.Next() {
+		rule, err := scanIntoRecurringRule(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, *rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func recurringRuleValues(rule entity.Rule) []any {
+	template := rule.Template()
+	schedule := rule.Schedule()
+
+	return []any{
+		rule.ID(),
+		rule.UserID(),
+		template.Amount,
+		template.Currency,
+		template.Description,
+		string(template.ExpenseType),
+		nullableString(template.CategoryID),
+		nullableString(template.AccountID),
+		strings.Join(template.Tags, ","),
+		string(schedule.Frequency),
+		schedule.Interval,
+		schedule.DayOfMonth,
+		schedule.Start.Format("2006-01-02"),
+		nullableString(formatOptionalDate(schedule.End)),
+		schedule.Count,
+		rule.Paused(),
+		nullableString(formatOptionalDate(rule.GeneratedThrough())),
+	}
+}
+
+func expectAffectedRule(res sql.Result, id string) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrRuleNotFound, id)
+	}
+
+	return nil
+}
+
+func scanIntoRecurringRule(scan func(dest ...any) error) (*entity.Rule, error) {
+	var (
+		id               string
+		userID           int64
+		template         entity.Template
+		expenseType      string
+		categoryID       sql.NullString
+		accountID        sql.NullString
+		tags             string
+		frequency        string
+		interval         int
+		dayOfMonth       int
+		startDate        string
+		endDate          sql.NullString
+		count            int
+		paused           bool
+		generatedThrough sql.NullString
+	)
+
+	err := scan(&id, &userID, &template.Amount, &template.Currency, &template.Description, &expenseType, &categoryID, &accountID, &tags,
+		&frequency, &interval, &dayOfMonth, &startDate, &endDate, &count, &paused, &generatedThrough)
+	if err != nil {
+		return nil, err
+	}
+
+	template.ExpenseType = expenseEntity.ExpenseType(expenseType)
+	template.CategoryID = categoryID.String
+	template.AccountID = accountID.String
+	template.Tags = make([]string, 0)
+	if tags != "" {
+		template.Tags = strings.Split(tags, ",")
+	}
+
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := parseNullableDate(endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := entity.NewSchedule(entity.Frequency(frequency), interval, dayOfMonth, start, end, count)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := entity.NewRule(userID, template, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	through, err := parseNullableDate(generatedThrough)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.SetID(id)
+	rule.SetPaused(paused)
+	rule.SetGeneratedThrough(through)
+
+	return rule, nil
+}
+
+func parseNullableDate(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRule returns a monthly rule, starting on start, for expenses in the
+// given category and account.
+func newTestRule(t *testing.T, userID int64, categoryID, accountID string, start time.Time) *entity.Rule {
+	t.Helper()
+
+	template, err := entity.NewTemplate(userID, 4500, "EUR", "Gym", expenseEntity.FixedExpense, categoryID, accountID, nil)
+	require.NoError(t, err)
+
+	schedule, err := entity.NewSchedule(entity.Monthly, 1, 0, start, nil, 0)
+	require.NoError(t, err)
+
+	rule, err := entity.NewRule(userID, template, schedule)
+	require.NoError(t, err)
+
+	return rule
+}
+
+func TestRecurringRulesSQLiteRepository_CRUD(t *testing.T) {
+	repo := NewRecurringRulesSQLiteRepository(newTestDB(t))
+
+	template, err := entity.NewTemplate(1, 1299, "", "Streaming", expenseEntity.FixedExpense, "", "", []string{"subscriptions", "tv"})
+	require.NoError(t, err)
+	end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	schedule, err := entity.NewSchedule(entity.Monthly, 1, 0, testDate(t, "2026-01-15"), &end, 0)
+	require.NoError(t, err)
+	rule, err := entity.NewRule(1, template, schedule)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*rule))
+
+	found, err := repo.FindByID(1, rule.ID())
+	require.NoError(t, err)
+	assert.Equal(t, rule, found)
+
+	_, err = repo.FindByID(2, rule.ID())
+	assert.ErrorIs(t, err, ErrRuleNotFound)
+
+	found.Pause()
+	require.NoError(t, repo.Update(*found))
+
+	all, err := repo.FindAll(1)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.True(t, all[0].Paused())
+	assert.Equal(t, []string{"subscriptions", "tv"}, all[0].Tags())
+
+	date := time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.ClaimOccurrence(Occurrence{RuleID: rule.ID(), Date: date, Status: OccurrenceSkipped}))
+
+	assert.ErrorIs(t, repo.Delete(2, rule.ID()), ErrRuleNotFound)
+	require.NoError(t, repo.Delete(1, rule.ID()))
+
+	occurrences, err := repo.Occurrences(rule.ID(), date, date)
+	require.NoError(t, err)
+	assert.Empty(t, occurrences, "Deleting a rule should delete its occurrences")
+}
+
+func TestRecurringRulesSQLiteRepository_DueAndOccurrences(t *testing.T) {
+	repo := NewRecurringRulesSQLiteRepository(newTestDB(t))
+
+	active := newTestRule(t, 1, "", "", testDate(t, "2026-01-15"))
+	future := newTestRule(t, 2, "", "", testDate(t, "2026-06-01"))
+	paused := newTestRule(t, 1, "", "", testDate(t, "2026-01-01"))
+	paused.Pause()
+	for _, r := range []*entity.Rule{active, future, paused} {
+		require.NoError(t, repo.Save(*r))
+	}
+
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	due, err := repo.FindDue(day)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, active.ID(), due[0].ID())
+
+	require.NoError(t, repo.SetGeneratedThrough(active.ID(), day))
+	due, err = repo.FindDue(day)
+	require.NoError(t, err)
+	assert.Empty(t, due, "Rules handled through the day should not be due")
+
+	jan := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	claim := Occurrence{RuleID: active.ID(), Date: jan, Status: OccurrenceCreated}
+	require.NoError(t, repo.ClaimOccurrence(claim))
+	assert.ErrorIs(t, repo.ClaimOccurrence(claim), ErrOccurrenceTaken)
+
+	require.NoError(t, repo.SetOccurrenceExpense(active.ID(), jan, "expense-1"))
+
+	occurrences, err := repo.Occurrences(active.ID(), jan, day)
+	require.NoError(t, err)
+	assert.Equal(t, []Occurrence{{RuleID: active.ID(), Date: jan, Status: OccurrenceCreated, ExpenseID: "expense-1"}}, occurrences)
+
+	require.NoError(t, repo.ReleaseOccurrence(active.ID(), jan))
+	assert.NoError(t, repo.ClaimOccurrence(claim), "A released date can be claimed again")
+}
@@ -0,0 +1,233 @@
+package data
+
+import (
+	"database/sql"
+	"math"
+	"strings"
+	"time"
+)
+
+type ReportsSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewReportsSQLiteRepository(db *sql.DB) *ReportsSQLiteRepository {
+	return &ReportsSQLiteRepository{db: db}
+}
+
+var summaryGroupKeys = map[SummaryGrouping]string{
+	GroupByMonth: "strftime('%Y-%m', date)",
+	GroupByWeek:  "strftime('%G-W%V', date)",
+	GroupByType:  "expense_type",
+}
+
+// reportBucket holds amounts that share a group key, currency and date, the
+// finest granularity at which an exchange rate applies.
+type reportBucket struct {
+	key      string
+	currency string
+	date     time.Time
+	income   int64
+	expenses int64
+	count    int64
+}
+
+func (r *ReportsSQLiteRepository) SummarizeExpenses(filter SummaryFilter) (*ExpenseSummary, error) {
+	where, args := buildSummaryWhere(filter)
+
+	key := summaryGroupKeys[filter.GroupBy]
+	if key == "" {
+		key = summaryGroupKeys[GroupByMonth]
+	}
+
+	buckets, err := r.queryBuckets(
+		"SELECT "+key+" AS group_key, currency, date, 0, SUM(amount), COUNT(*) FROM expenses"+where+" AND deleted_at IS NULL"+
+			" GROUP BY group_key, currency, date ORDER BY group_key, currency, date",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &ExpenseSummary{Groups: make([]SummaryRow, 0)}
+
+	for _, bucket := range buckets {
+		amount, err := convertAmount(filter.Converter, bucket.expenses, bucket.currency, bucket.date)
+		if err != nil {
+			return nil, err
+		}
+
+		if n := len(summary.Groups); n == 0 || summary.Groups[n-1].Key != bucket.key {
+			summary.Groups = append(summary.Groups, SummaryRow{Key: bucket.key})
+		}
+
+		group := &summary.Groups[len(summary.Groups)-1]
+		group.Total += amount
+		group.Count += bucket.count
+		summary.Totals.Total += amount
+		summary.Totals.Count += bucket.count
+	}
+
+	for i := range summary.Groups {
+		summary.Groups[i].Average = average(summary.Groups[i].Total, summary.Groups[i].Count)
+	}
+	summary.Totals.Average = average(summary.Totals.Total, summary.Totals.Count)
+
+	return summary, nil
+}
+
+func (r *ReportsSQLiteRepository) SummarizeCashflow(filter SummaryFilter) (*Cashflow, error) {
+	where, args := buildSummaryWhere(filter)
+
+	key := summaryGroupKeys[filter.GroupBy]
+	if filter.GroupBy != GroupByWeek {
+		key = summaryGroupKeys[GroupByMonth]
+	}
+
+	buckets, err := r.queryBuckets(
+		`SELECT period, currency, date, SUM(income), SUM(expenses), COUNT(*) FROM (
+			SELECT `+key+` AS period, currency, date, amount AS income, 0 AS expenses FROM incomes`+where+`
+			UNION ALL
+			SELECT `+key+`, currency, date, 0, amount FROM expenses`+where+` AND deleted_at IS NULL
+		) GROUP BY period, currency, date ORDER BY period, currency, date`,
+		append(args, args...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cashflow := &Cashflow{Periods: make([]CashflowRow, 0)}
+
+	for _, bucket := range buckets {
+		income, err := convertAmount(filter.Converter, bucket.income, bucket.currency, bucket.date)
+		if err != nil {
+			return nil, err
+		}
+
+		expenses, err := convertAmount(filter.Converter, bucket.expenses, bucket.currency, bucket.date)
+		if err != nil {
+			return nil, err
+		}
+
+		if n := len(cashflow.Periods); n == 0 || cashflow.Periods[n-1].Key != bucket.key {
+			cashflow.Periods = append(cashflow.Periods, CashflowRow{Key: bucket.key})
+		}
+
+		period := &cashflow.Periods[len(cashflow.Periods)-1]
+		period.Income += income
+		period.Expenses += expenses
+		cashflow.Totals.Income += income
+		cashflow.Totals.Expenses += expenses
+	}
+
+	return cashflow, nil
+}
+
+func (r *ReportsSQLiteRepository) SumExpenses(filter SpendingFilter) (int64, error) {
+	conditions := []string{"user_id = ?", "deleted_at IS NULL", "date >= ?", "date <= ?"}
+	args := []any{filter.UserID, filter.From.Format("2006-01-02"), filter.To.Format("2006-01-02")}
+
+	if filter.CategoryID != "" {
+		conditions = append(conditions, `category_id IN (
+			WITH RECURSIVE subtree(id) AS (
+				SELECT id FROM categories WHERE id = ? AND user_id = ?
+				UNION ALL
+				SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+			)
+			SELECT id FROM subtree)`)
+		args = append(args, filter.CategoryID, filter.UserID)
+	}
+
+	if filter.ExpenseType != "" {
+		conditions = append(conditions, "expense_type = ?")
+		args = append(args, string(filter.ExpenseType))
+	}
+
+	buckets, err := r.queryBuckets(
+		"SELECT '', currency, date, 0, SUM(amount), COUNT(*) FROM expenses WHERE "+strings.Join(conditions, " AND ")+
+			" GROUP BY currency, date ORDER BY currency, date",
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, bucket := range buckets {
+		amount, err := convertAmount(filter.Converter, bucket.expenses, bucket.currency, bucket.date)
+		if err != nil {
+			return 0, err
+		}
+		total += amount
+	}
+
+	return total, nil
+}
+
+// queryBuckets reads every bucket before any conversion happens, since
+// converters may need the database themselves.
+func (r *ReportsSQLiteRepository) queryBuckets(query string, args ...any) ([]reportBucket, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := make([]reportBucket, 0)
+
+	for rows.Next() {
+		var (
+			bucket reportBucket
+			date   string
+		)
+
+		if err := rows.Scan(&bucket.key, &bucket.currency, &date, &bucket.income, &bucket.expenses, &bucket.count); err != nil {
+			return nil, err
+		}
+
+		if bucket.date, err = time.Parse("2006-01-02", date); err != nil {
+			return nil, err
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+func buildSummaryWhere(filter SummaryFilter) (string, []any) {
+	conditions := []string{"user_id = ?"}
+	args := []any{filter.UserID}
+
+	if filter.From != nil {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, filter.From.Format("2006-01-02"))
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, filter.To.Format("2006-01-02"))
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func convertAmount(converter CurrencyConverter, amount int64, currency string, date time.Time) (int64, error) {
+	if converter == nil || amount == 0 {
+		return amount, nil
+	}
+
+	return converter.Convert(amount, currency, date)
+}
+
+func average(total, count int64) int64 {
+	if count == 0 {
+		return 0
+	}
+
+	return int64(math.Round(float64(total) / float64(count)))
+}
@@ -0,0 +1,175 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	incomeEntity "github.com/MarioGN/finance-manager-api/internal/incomes/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportsSQLiteRepository_SummarizeExpenses(t *testing.T) {
+	db := newTestDB(t)
+	expenses := NewExpensesSQLiteRepository(db)
+	reports := NewReportsSQLiteRepository(db)
+
+	seed := []*entity.Expense{
+		newCustomTestExpense(t, 1, 150000, "Rent", "2026-01-05", entity.FixedExpense),
+		newCustomTestExpense(t, 1, 8500, "Electricity bill", "2026-01-20", entity.VariableExpense),
+		newCustomTestExpense(t, 1, 2500, "Dinner", "2026-02-02", entity.UnplannedExpense),
+		newCustomTestExpense(t, 1, 4500, "Groceries", "2026-02-03", entity.VariableExpense),
+		newCustomTestExpense(t, 2, 9900, "Groceries", "2026-02-15", entity.VariableExpense),
+	}
+	for _, e := range seed {
+		require.NoError(t, expenses.Save(*e))
+	}
+
+	trashed := newCustomTestExpense(t, 1, 99900, "Deleted by mistake", "2026-01-07", entity.UnplannedExpense)
+	require.NoError(t, expenses.Save(*trashed))
+	trashed.SetDeletedAt(time.Now())
+	require.NoError(t, expenses.Trash(*trashed))
+
+	t.Run("Group by month", func(t *testing.T) {
+		summary, err := reports.SummarizeExpenses(SummaryFilter{UserID: 1, GroupBy: GroupByMonth})
+		require.NoError(t, err)
+
+		assert.Equal(t, SummaryRow{Total: 165500, Count: 4, Average: 41375}, summary.Totals)
+		assert.Equal(t, []SummaryRow{
+			{Key: "2026-01", Total: 158500, Count: 2, Average: 79250},
+			{Key: "2026-02", Total: 7000, Count: 2, Average: 3500},
+		}, summary.Groups)
+	})
+
+	t.Run("Group by ISO week", func(t *testing.T) {
+		summary, err := reports.SummarizeExpenses(SummaryFilter{UserID: 1, GroupBy: GroupByWeek})
+		require.NoError(t, err)
+
+		keys := make([]string, 0, len(summary.Groups))
+		for _, g := range summary.Groups {
+			keys = append(keys, g.Key)
+		}
+		assert.Equal(t, []string{"2026-W02", "2026-W04", "2026-W06"}, keys)
+	})
+
+	t.Run("Group by type within a date range", func(t *testing.T) {
+		from := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+
+		summary, err := reports.SummarizeExpenses(SummaryFilter{UserID: 1, From: &from, To: &to, GroupBy: GroupByType})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(3), summary.Totals.Count)
+		assert.Equal(t, []SummaryRow{
+			{Key: "unplanned", Total: 2500, Count: 1, Average: 2500},
+			{Key: "variable", Total: 13000, Count: 2, Average: 6500},
+		}, summary.Groups)
+	})
+
+	t.Run("Empty range", func(t *testing.T) {
+		from := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		summary, err := reports.SummarizeExpenses(SummaryFilter{UserID: 1, From: &from, GroupBy: GroupByMonth})
+		require.NoError(t, err)
+
+		assert.Equal(t, SummaryRow{}, summary.Totals)
+		assert.Empty(t, summary.Groups)
+	})
+}
+
+func TestReportsSQLiteRepository_SummarizeCashflow(t *testing.T) {
+	db := newTestDB(t)
+	expenses := NewExpensesSQLiteRepository(db)
+	incomes := NewIncomesSQLiteRepository(db)
+	reports := NewReportsSQLiteRepository(db)
+
+	for _, e := range []*entity.Expense{
+		newCustomTestExpense(t, 1, 150000, "Rent", "2026-01-05", entity.FixedExpense),
+		newCustomTestExpense(t, 1, 2500, "Dinner", "2026-03-02", entity.UnplannedExpense),
+		newCustomTestExpense(t, 2, 9900, "Groceries", "2026-01-15", entity.VariableExpense),
+	} {
+		require.NoError(t, expenses.Save(*e))
+	}
+
+	for _, i := range []struct {
+		userID int64
+		amount int64
+		date   string
+	}{
+		{1, 400000, "2026-01-30"},
+		{1, 400000, "2026-02-27"},
+		{2, 999900, "2026-01-30"},
+	} {
+		income, err := incomeEntity.NewIncome(i.userID, i.amount, "Salary", testDate(t, i.date), true)
+		require.NoError(t, err)
+		require.NoError(t, incomes.Save(*income))
+	}
+
+	cashflow, err := reports.SummarizeCashflow(SummaryFilter{UserID: 1, GroupBy: GroupByMonth})
+	require.NoError(t, err)
+
+	assert.Equal(t, CashflowRow{Income: 800000, Expenses: 152500}, cashflow.Totals)
+	assert.Equal(t, int64(647500), cashflow.Totals.Net())
+	assert.Equal(t, []CashflowRow{
+		{Key: "2026-01", Income: 400000, Expenses: 150000},
+		{Key: "2026-02", Income: 400000, Expenses: 0},
+		{Key: "2026-03", Income: 0, Expenses: 2500},
+	}, cashflow.Periods)
+
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	cashflow, err = reports.SummarizeCashflow(SummaryFilter{UserID: 1, From: &from, GroupBy: GroupByWeek})
+	require.NoError(t, err)
+
+	assert.Equal(t, []CashflowRow{
+		{Key: "2026-W09", Income: 400000, Expenses: 0},
+		{Key: "2026-W10", Income: 0, Expenses: 2500},
+	}, cashflow.Periods)
+
+	empty, err := reports.SummarizeCashflow(SummaryFilter{UserID: 3})
+	require.NoError(t, err)
+	assert.NotNil(t, empty.Periods)
+	assert.Zero(t, empty.Totals.Net())
+}
+
+// doublingConverter converts USD at a fixed 2:1 and leaves EUR alone.
+type doublingConverter struct{}
+
+func (doublingConverter) Convert(amount int64, currency string, _ time.Time) (int64, error) {
+	switch currency {
+	case "EUR":
+		return amount, nil
+	case "USD":
+		return amount * 2, nil
+	default:
+		return 0, ErrExchangeRateNotFound
+	}
+}
+
+func TestReportsSQLiteRepository_ConvertsCurrencies(t *testing.T) {
+	db := newTestDB(t)
+	expenses := NewExpensesSQLiteRepository(db)
+	reports := NewReportsSQLiteRepository(db)
+
+	euro := newCustomTestExpense(t, 1, 1000, "Lunch", "2026-01-05", entity.VariableExpense)
+	dollar := newCustomTestExpense(t, 1, 1500, "Book", "2026-01-06", entity.VariableExpense)
+	require.NoError(t, dollar.SetCurrency("USD"))
+	for _, e := range []*entity.Expense{euro, dollar} {
+		require.NoError(t, expenses.Save(*e))
+	}
+
+	summary, err := reports.SummarizeExpenses(SummaryFilter{UserID: 1, Converter: doublingConverter{}})
+	require.NoError(t, err)
+	assert.Equal(t, SummaryRow{Total: 4000, Count: 2, Average: 2000}, summary.Totals)
+
+	cashflow, err := reports.SummarizeCashflow(SummaryFilter{UserID: 1, Converter: doublingConverter{}})
+	require.NoError(t, err)
+	assert.Equal(t, []CashflowRow{{Key: "2026-01", Expenses: 4000}}, cashflow.Periods)
+
+	yen := newCustomTestExpense(t, 1, 500, "Snack", "2026-01-07", entity.VariableExpense)
+	require.NoError(t, yen.SetCurrency("JPY"))
+	require.NoError(t, expenses.Save(*yen))
+
+	_, err = reports.SummarizeExpenses(SummaryFilter{UserID: 1, Converter: doublingConverter{}})
+	assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+}
@@ -2,47 +2,93 @@ package data
 
 import (
 	"database/sql"
+	"errors"
 
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
 	_ "github.com/mattn/go-sqlite3"
 )
 
 type Store struct {
-	Expenses ExpenseRepository
-	db       *sql.DB
+	Expenses   ExpenseRepository
+	Incomes    IncomeRepository
+	Accounts   AccountRepository
+	Budgets    BudgetRepository
+	Webhooks   WebhookRepository
+	Imports    ImportRepository
+	Transfers  TransferRepository
+	Users      repository.UserRepository
+	Reports    ReportRepository
+	Categories CategoryRepository
+	Rates      ExchangeRateRepository
+	Recurring  RecurringRuleRepository
+	Tags       TagRepository
+	db         *sql.DB
 }
 
-func NewStore() (*Store, error) {
-	db, err := sql.Open("sqlite3", "./database.db")
+// NewStore opens the database and applies any pending migrations.
+func NewStore(dsn string) (*Store, error) {
+	store, err := Open(dsn)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := db.Ping(); err != nil {
+	migrator, err := store.Migrator()
+	if err != nil {
 		return nil, err
 	}
 
-	store := &Store{
-		db:       db,
-		Expenses: NewExpensesSQLiteRepository(db),
+	if _, err := migrator.Up(); err != nil {
+		return nil, err
 	}
 
-	if err := store.init(); err != nil {
+	return store, nil
+}
+
+// Open opens the database without touching its schema.
+func Open(dsn string) (*Store, error) {
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
 		return nil, err
 	}
 
-	return store, nil
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec("PRAGMA journal_mode = WAL; PRAGMA busy_timeout = 5000;"); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return newStore(db), nil
+}
+
+func newStore(db *sql.DB) *Store {
+	return &Store{
+		db:         db,
+		Expenses:   NewExpensesSQLiteRepository(db),
+		Incomes:    NewIncomesSQLiteRepository(db),
+		Accounts:   NewAccountsSQLiteRepository(db),
+		Budgets:    NewBudgetsSQLiteRepository(db),
+		Webhooks:   NewWebhooksSQLiteRepository(db),
+		Imports:    NewImportsSQLiteRepository(db),
+		Transfers:  NewTransfersSQLiteRepository(db),
+		Users:      NewUsersSQLiteRepository(db),
+		Reports:    NewReportsSQLiteRepository(db),
+		Categories: NewCategoriesSQLiteRepository(db),
+		Rates:      NewExchangeRatesSQLiteRepository(db),
+		Recurring:  NewRecurringRulesSQLiteRepository(db),
+		Tags:       NewTagsSQLiteRepository(db),
+	}
+}
+
+func (s *Store) Migrator() (*Migrator, error) {
+	return NewMigrator(s.db)
 }
 
-func (s *Store) init() error {
-	schema := `
-	CREATE TABLE IF NOT EXISTS expenses (
-		id TEXT PRIMARY KEY,
-		amount REAL NOT NULL,
-		description TEXT,
-		date TEXT NOT NULL,
-		expense_type TEXT NOT NULL
-	);
-	`
-	_, err := s.db.Exec(schema)
-	return err
+// Close folds the write-ahead log back into the database file and closes
+// every connection. It must only be called once in-flight requests are done.
+func (s *Store) Close() error {
+	_, checkpointErr := s.db.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+	return errors.Join(checkpointErr, s.db.Close())
 }
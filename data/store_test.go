@@ -0,0 +1,70 @@
+package data
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db := openTestDB(t)
+
+	migrator, err := NewMigrator(db)
+	require.NoError(t, err)
+
+	_, err = migrator.Up()
+	require.NoError(t, err)
+
+	return db
+}
+
+// testDate parses a YYYY-MM-DD date for test fixtures.
+func testDate(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse("2006-01-02", value)
+	require.NoError(t, err)
+
+	return parsed
+}
+
+func TestStore_CloseCheckpointsWAL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "finance.db")
+
+	store, err := NewStore(path)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Expenses.Save(*newTestExpense(t, 1)))
+
+	require.NoError(t, store.Close())
+
+	if info, err := os.Stat(path + "-wal"); err == nil {
+		assert.Zero(t, info.Size(), "WAL should be checkpointed into the database file")
+	}
+
+	reopened, err := NewStore(path)
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	expenses, err := reopened.Expenses.FindAll(ExpenseFilter{UserID: 1})
+	require.NoError(t, err)
+	assert.Len(t, expenses, 1)
+}
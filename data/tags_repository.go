@@ -0,0 +1,176 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/internal/tags/entity"
+)
+
+type TagsSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewTagsSQLiteRepository(db *sql.DB) *TagsSQLiteRepository {
+	return &TagsSQLiteRepository{db: db}
+}
+
+func (r *TagsSQLiteRepository) FindAll(userID int64) ([]TagUsage, error) {
+	tags := make([]TagUsage, 0)
+
+	rows, err := r.db.Query(
+		`SELECT t.id, t.user_id, t.name, COUNT(e.id)
+		FROM tags t
+		LEFT JOIN expense_tags et ON et.tag_id = t.id
+		LEFT JOIN expenses e ON e.id = et.expense_id AND e.deleted_at IS NULL
+		WHERE t.user_id = ?
+		GROUP BY t.id
+		ORDER BY t.name COLLATE NOCASE, t.id`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var usageCount int64
+		tag, err := scanIntoTag(func(dest ...any) error {
+			return rows.Scan(append(dest, &usageCount)...)
+		})
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, TagUsage{Tag: *tag, UsageCount: usageCount})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (r *TagsSQLiteRepository) FindByID(userID int64, id string) (*entity.Tag, error) {
+	row := r.db.QueryRow("SELECT id, user_id, name FROM tags WHERE id = ? AND user_id = ?", id, userID)
+
+	tag, err := scanIntoTag(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrTagNotFound, id)
+	}
+
+	return tag, err
+}
+
+func (r *TagsSQLiteRepository) Update(tag entity.Tag) error {
+	res, err := r.db.Exec("UPDATE tags SET name = ? WHERE id = ? AND user_id = ?", tag.Name(), tag.ID(), tag.UserID())
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: %s", ErrTagNameTaken, tag.Name())
+	}
+	if err != nil {
+		return err
+	}
+
+	return expectAffectedTag(res, tag.ID())
+}
+
+// Merge moves every expense tagged with the source tag over to the target
+// tag and deletes the source tag in a single transaction. Expenses already
+// carrying both tags keep a single link to the target.
+func (r *TagsSQLiteRepository) Merge(userID int64, sourceID, targetID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists int
+	if err := tx.QueryRow("SELECT 1 FROM tags WHERE id = ? AND user_id = ?", targetID, userID).Scan(&exists); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", ErrTagNotFound, targetID)
+		}
+		return err
+	}
+
+	res, err := tx.Exec("DELETE FROM tags WHERE id = ? AND user_id = ?", sourceID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := expectAffectedTag(res, sourceID); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		"INSERT OR IGNORE INTO expense_tags (expense_id, tag_id) SELECT expense_id, ? FROM expense_tags WHERE tag_id = ?",
+		targetID, sourceID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM expense_tags WHERE tag_id = ?", sourceID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes a tag and unlinks it from every expense.
+func (r *TagsSQLiteRepository) Delete(userID int64, id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM tags WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := expectAffectedTag(res, id); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM expense_tags WHERE tag_id = ?", id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func expectAffectedTag(res sql.Result, id string) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrTagNotFound, id)
+	}
+
+	return nil
+}
+
+func scanIntoTag(scan func(dest ...any) error) (*entity.Tag, error) {
+	var (
+		id     string
+		userID int64
+		name   string
+	)
+
+	if err := scan(&id, &userID, &name); err != nil {
+		return nil, err
+	}
+
+	tag, err := entity.NewTag(userID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag.SetID(id)
+
+	return tag, nil
+}
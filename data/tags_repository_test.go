@@ -0,0 +1,137 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func saveTaggedExpense(t *testing.T, repo *ExpensesSQLiteRepository, userID int64, description string, tags ...string) *entity.Expense {
+	t.Helper()
+
+	expense := newCustomTestExpense(t, userID, 1000, description, "2026-04-01", entity.VariableExpense)
+	require.NoError(t, expense.SetTags(tags))
+	require.NoError(t, repo.Save(*expense))
+
+	return expense
+}
+
+func descriptions(expenses []entity.Expense) []string {
+	result := make([]string, 0, len(expenses))
+	for _, e := range expenses {
+		result = append(result, e.Description())
+	}
+	return result
+}
+
+func TestExpensesSQLiteRepository_Tags(t *testing.T) {
+	repo := NewExpensesSQLiteRepository(newTestDB(t))
+
+	hotel := saveTaggedExpense(t, repo, 1, "Hotel", "vacation-2026", "reimbursable")
+	saveTaggedExpense(t, repo, 1, "Museum", "Vacation-2026")
+	saveTaggedExpense(t, repo, 1, "Taxi", "reimbursable")
+	saveTaggedExpense(t, repo, 1, "Coffee")
+	saveTaggedExpense(t, repo, 2, "Other user", "vacation-2026", "reimbursable")
+
+	found, err := repo.FindByID(1, hotel.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reimbursable", "vacation-2026"}, found.Tags())
+
+	tests := []struct {
+		name     string
+		filter   ExpenseFilter
+		expected []string
+	}{
+		{"any tag", ExpenseFilter{AnyTags: []string{"VACATION-2026"}}, []string{"Hotel", "Museum"}},
+		{"any of several tags", ExpenseFilter{AnyTags: []string{"vacation-2026", "reimbursable"}}, []string{"Hotel", "Museum", "Taxi"}},
+		{"all tags", ExpenseFilter{AllTags: []string{"vacation-2026", "reimbursable"}}, []string{"Hotel"}},
+		{"unknown tag", ExpenseFilter{AnyTags: []string{"nope"}}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.filter.UserID = 1
+			tt.filter.SortField = SortByDescription
+
+			expenses, err := repo.FindAll(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, descriptions(expenses))
+
+			count, err := repo.Count(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(tt.expected)), count)
+		})
+	}
+
+	require.NoError(t, found.SetTags([]string{"work"}))
+	require.NoError(t, repo.Update(*found))
+
+	updated, err := repo.FindByID(1, hotel.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, updated.Tags())
+}
+
+func TestTagsSQLiteRepository_UsageRenameMergeDelete(t *testing.T) {
+	db := newTestDB(t)
+	expenses := NewExpensesSQLiteRepository(db)
+	repo := NewTagsSQLiteRepository(db)
+
+	hotel := saveTaggedExpense(t, expenses, 1, "Hotel", "vacation", "holiday")
+	saveTaggedExpense(t, expenses, 1, "Museum", "holiday")
+	saveTaggedExpense(t, expenses, 2, "Other user", "holiday")
+
+	usage := func() map[string]int64 {
+		t.Helper()
+		tags, err := repo.FindAll(1)
+		require.NoError(t, err)
+		result := map[string]int64{}
+		for _, u := range tags {
+			result[u.Tag.Name()] = u.UsageCount
+		}
+		return result
+	}
+	tagID := func(name string) string {
+		t.Helper()
+		tags, err := repo.FindAll(1)
+		require.NoError(t, err)
+		for _, u := range tags {
+			if u.Tag.Name() == name {
+				return u.Tag.ID()
+			}
+		}
+		t.Fatalf("tag %q not found", name)
+		return ""
+	}
+
+	assert.Equal(t, map[string]int64{"holiday": 2, "vacation": 1}, usage())
+
+	holiday, err := repo.FindByID(1, tagID("holiday"))
+	require.NoError(t, err)
+	require.NoError(t, holiday.SetName("Vacation"))
+	assert.ErrorIs(t, repo.Update(*holiday), ErrTagNameTaken)
+
+	require.NoError(t, holiday.SetName("trip"))
+	require.NoError(t, repo.Update(*holiday))
+
+	_, err = repo.FindByID(2, holiday.ID())
+	assert.ErrorIs(t, err, ErrTagNotFound)
+
+	require.NoError(t, repo.Merge(1, tagID("vacation"), holiday.ID()))
+	assert.Equal(t, map[string]int64{"trip": 2}, usage())
+
+	found, err := expenses.FindByID(1, hotel.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"trip"}, found.Tags())
+
+	assert.ErrorIs(t, repo.Merge(1, "missing", holiday.ID()), ErrTagNotFound)
+	assert.ErrorIs(t, repo.Delete(2, holiday.ID()), ErrTagNotFound)
+
+	require.NoError(t, repo.Delete(1, holiday.ID()))
+	assert.Empty(t, usage())
+
+	found, err = expenses.FindByID(1, hotel.ID())
+	require.NoError(t, err)
+	assert.Empty(t, found.Tags())
+}
@@ -0,0 +1,140 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+)
+
+type TransfersSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewTransfersSQLiteRepository(db *sql.DB) *TransfersSQLiteRepository {
+	return &TransfersSQLiteRepository{db: db}
+}
+
+const transferColumns = "id, user_id, from_account_id, to_account_id, amount, date, description"
+
+func (r *TransfersSQLiteRepository) FindAll(filter TransferFilter) ([]entity.Transfer, error) {
+	transfers := make([]entity.Transfer, 0)
+
+	conditions := []string{"user_id = ?"}
+	args := []any{filter.UserID}
+
+	if filter.AccountID != "" {
+		conditions = append(conditions, "? IN (from_account_id, to_account_id)")
+		args = append(args, filter.AccountID)
+	}
+
+	if filter.From != nil {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, filter.From.Format("2006-01-02"))
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, filter.To.Format("2006-01-02"))
+	}
+
+	rows, err := r.db.Query(
+		"SELECT "+transferColumns+" FROM transfers WHERE "+strings.Join(conditions, " AND ")+" ORDER BY date DESC, id DESC",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		transfer, err := scanIntoTransfer(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, *transfer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func (r *TransfersSQLiteRepository) FindByID(userID int64, id string) (*entity.Transfer, error) {
+	row := r.db.QueryRow("SELECT "+transferColumns+" FROM transfers WHERE id = ? AND user_id = ?", id, userID)
+
+	transfer, err := scanIntoTransfer(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrTransferNotFound, id)
+	}
+
+	return transfer, err
+}
+
+func (r *TransfersSQLiteRepository) Save(transfer entity.Transfer) error {
+	_, err := r.db.Exec(
+		"INSERT INTO transfers ("+transferColumns+") VALUES (?, ?, ?, ?, ?, ?, ?)",
+		transfer.ID(),
+		transfer.UserID(),
+		transfer.FromAccountID(),
+		transfer.ToAccountID(),
+		transfer.Amount(),
+		transfer.Date().Format("2006-01-02"),
+		transfer.Description(),
+	)
+
+	return err
+}
+
+func (r *TransfersSQLiteRepository) Delete(userID int64, id string) error {
+	res, err := r.db.Exec("DELETE FROM transfers WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrTransferNotFound, id)
+	}
+
+	return nil
+}
+
+func scanIntoTransfer(scan func(dest ...any) error) (*entity.Transfer, error) {
+	var (
+		id            string
+		userID        int64
+		fromAccountID string
+		toAccountID   string
+		amount        int64
+		date          string
+		description   string
+	)
+
+	if err := scan(&id, &userID, &fromAccountID, &toAccountID, &amount, &date, &description); err != nil {
+		return nil, err
+	}
+
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := entity.NewTransfer(userID, fromAccountID, toAccountID, amount, parsed, description)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer.SetID(id)
+
+	return transfer, nil
+}
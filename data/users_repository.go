@@ -0,0 +1,110 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	"github.com/mattn/go-sqlite3"
+)
+
+type UsersSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewUsersSQLiteRepository(db *sql.DB) *UsersSQLiteRepository {
+	return &UsersSQLiteRepository{db: db}
+}
+
+func (r *UsersSQLiteRepository) Save(user entity.UserAccount) (int64, error) {
+	res, err := r.db.Exec(
+		"INSERT INTO users (email, password_hash, base_currency) VALUES (?, ?, ?)",
+		user.Email(),
+		user.PasswordHash(),
+		user.BaseCurrency(),
+	)
+	if isUniqueViolation(err) {
+		return 0, &repository.EmailAlreadyRegisteredError{Email: user.Email()}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+func (r *UsersSQLiteRepository) FindByEmail(email string) (*entity.UserAccount, error) {
+	row := r.db.QueryRow("SELECT id, email, password_hash, base_currency FROM users WHERE email = ? COLLATE NOCASE", email)
+	return scanIntoUser(row)
+}
+
+func (r *UsersSQLiteRepository) FindByID(id int64) (*entity.UserAccount, error) {
+	row := r.db.QueryRow("SELECT id, email, password_hash, base_currency FROM users WHERE id = ?", id)
+	return scanIntoUser(row)
+}
+
+func (r *UsersSQLiteRepository) UpdatePassword(id int64, passwordHash string) error {
+	res, err := r.db.Exec("UPDATE users SET password_hash = ? WHERE id = ?", passwordHash, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *UsersSQLiteRepository) UpdateBaseCurrency(id int64, currency string) error {
+	res, err := r.db.Exec("UPDATE users SET base_currency = ? WHERE id = ?", currency, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func scanIntoUser(row *sql.Row) (*entity.UserAccount, error) {
+	var (
+		id           int64
+		email        string
+		passwordHash string
+		baseCurrency string
+	)
+
+	err := row.Scan(&id, &email, &passwordHash, &baseCurrency)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user := entity.RestoreUserAccount(id, email, passwordHash)
+	if err := user.SetBaseCurrency(baseCurrency); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
@@ -0,0 +1,73 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUsersRepository(t *testing.T) *UsersSQLiteRepository {
+	t.Helper()
+	return NewUsersSQLiteRepository(newTestDB(t))
+}
+
+func TestUsersSQLiteRepository_SaveAndFind(t *testing.T) {
+	repo := newTestUsersRepository(t)
+
+	user, err := entity.NewUserAccount("user@example.com", "password123")
+	require.NoError(t, err)
+
+	id, err := repo.Save(*user)
+	require.NoError(t, err)
+	assert.Greater(t, id, int64(0))
+
+	byEmail, err := repo.FindByEmail("USER@example.com")
+	require.NoError(t, err, "Email lookup should be case-insensitive")
+	assert.Equal(t, id, byEmail.ID())
+	assert.NoError(t, byEmail.ValidatePassword("password123"))
+
+	byID, err := repo.FindByID(id)
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", byID.Email())
+
+	_, err = repo.FindByID(id + 1)
+	assert.ErrorIs(t, err, repository.ErrUserNotFound)
+}
+
+func TestUsersSQLiteRepository_DuplicateEmail(t *testing.T) {
+	repo := newTestUsersRepository(t)
+
+	user, err := entity.NewUserAccount("user@example.com", "password123")
+	require.NoError(t, err)
+	_, err = repo.Save(*user)
+	require.NoError(t, err)
+
+	duplicate, err := entity.NewUserAccount("User@Example.com", "password456")
+	require.NoError(t, err)
+	_, err = repo.Save(*duplicate)
+
+	var alreadyRegistered *repository.EmailAlreadyRegisteredError
+	require.ErrorAs(t, err, &alreadyRegistered)
+	assert.Equal(t, "User@Example.com", alreadyRegistered.Email)
+}
+
+func TestUsersSQLiteRepository_UpdatePassword(t *testing.T) {
+	repo := newTestUsersRepository(t)
+
+	user, err := entity.NewUserAccount("user@example.com", "password123")
+	require.NoError(t, err)
+	id, err := repo.Save(*user)
+	require.NoError(t, err)
+
+	require.NoError(t, user.SetPassword("newPassword"))
+	require.NoError(t, repo.UpdatePassword(id, user.PasswordHash()))
+
+	stored, err := repo.FindByID(id)
+	require.NoError(t, err)
+	assert.NoError(t, stored.ValidatePassword("newPassword"))
+
+	assert.ErrorIs(t, repo.UpdatePassword(id+1, user.PasswordHash()), repository.ErrUserNotFound)
+}
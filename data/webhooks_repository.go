@@ -0,0 +1,311 @@
+package data
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+)
+
+type WebhooksSQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewWebhooksSQLiteRepository(db *sql.DB) *WebhooksSQLiteRepository {
+	return &WebhooksSQLiteRepository{db: db}
+}
+
+const (
+	webhookColumns  = "id, user_id, url, secret, active"
+	deliveryColumns = "id, user_id, webhook_id, event, payload, status, attempts, last_status_code, last_error, created_at, next_attempt_at, delivered_at"
+)
+
+func (r *WebhooksSQLiteRepository) FindAll(userID int64) ([]entity.Webhook, error) {
+	webhooks := make([]entity.Webhook, 0)
+
+	rows, err := r.db.Query("SELECT "+webhookColumns+" FROM webhooks WHERE user_id = ? ORDER BY url, id", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		webhook, err := scanIntoWebhook(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhooksSQLiteRepository) FindByID(userID int64, id string) (*entity.Webhook, error) {
+	row := r.db.QueryRow("SELECT "+webhookColumns+" FROM webhooks WHERE id = ? AND user_id = ?", id, userID)
+
+	webhook, err := scanIntoWebhook(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrWebhookNotFound, id)
+	}
+
+	return webhook, err
+}
+
+func (r *WebhooksSQLiteRepository) Save(webhook entity.Webhook) error {
+	_, err := r.db.Exec(
+		"INSERT INTO webhooks ("+webhookColumns+") VALUES (?, ?, ?, ?, ?)",
+		webhook.ID(),
+		webhook.UserID(),
+		webhook.URL(),
+		webhook.Secret(),
+		webhook.Active(),
+	)
+
+	return err
+}
+
+func (r *WebhooksSQLiteRepository) Update(webhook entity.Webhook) error {
+	res, err := r.db.Exec(
+		"UPDATE webhooks SET url = ?, active = ? WHERE id = ? AND user_id = ?",
+		webhook.URL(),
+		webhook.Active(),
+		webhook.ID(),
+		webhook.UserID(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return expectAffectedWebhook(res, webhook.ID())
+}
+
+func (r *WebhooksSQLiteRepository) Delete(userID int64, id string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM webhooks WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := expectAffectedWebhook(res, id); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM webhook_deliveries WHERE webhook_id = ?", id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *WebhooksSQLiteRepository) SaveDelivery(delivery entity.Delivery) error {
+	_, err := r.db.Exec(
+		"INSERT INTO webhook_deliveries ("+deliveryColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		delivery.ID(),
+		delivery.UserID(),
+		delivery.WebhookID(),
+		delivery.Event(),
+		string(delivery.Payload()),
+		string(delivery.Status()),
+		delivery.Attempts(),
+		delivery.LastStatusCode(),
+		delivery.LastError(),
+		formatTimestamp(delivery.CreatedAt()),
+		nullableString(formatOptionalTimestamp(delivery.NextAttemptAt())),
+		nullableString(formatOptionalTimestamp(delivery.DeliveredAt())),
+	)
+
+	return err
+}
+
+func (r *WebhooksSQLiteRepository) UpdateDelivery(delivery entity.Delivery) error {
+	_, err := r.db.Exec(
+		"UPDATE webhook_deliveries SET status = ?, attempts = ?, last_status_code = ?, last_error = ?, next_attempt_at = ?, delivered_at = ? WHERE id = ?",
+		string(delivery.Status()),
+		delivery.Attempts(),
+		delivery.LastStatusCode(),
+		delivery.LastError(),
+		nullableString(formatOptionalTimestamp(delivery.NextAttemptAt())),
+		nullableString(formatOptionalTimestamp(delivery.DeliveredAt())),
+		delivery.ID(),
+	)
+
+	return err
+}
+
+func (r *WebhooksSQLiteRepository) FindDeliveries(filter DeliveryFilter) ([]entity.Delivery, error) {
+	where, args := buildDeliveryWhere(filter)
+	query := "SELECT " + deliveryColumns + " FROM webhook_deliveries" + where + " ORDER BY created_at DESC, id DESC"
+
+	if filter.Limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	return r.queryDeliveries(query, args...)
+}
+
+func (r *WebhooksSQLiteRepository) CountDeliveries(filter DeliveryFilter) (int64, error) {
+	where, args := buildDeliveryWhere(filter)
+
+	var total int64
+	err := r.db.QueryRow("SELECT COUNT(*) FROM webhook_deliveries"+where, args...).Scan(&total)
+	return total, err
+}
+
+func (r *WebhooksSQLiteRepository) FindDueDeliveries(now time.Time, limit int) ([]entity.Delivery, error) {
+	return r.queryDeliveries(
+		"SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE status = ? AND next_attempt_at <= ? ORDER BY next_attempt_at, created_at, id LIMIT ?",
+		string(entity.DeliveryPending), formatTimestamp(now), limit,
+	)
+}
+
+func (r *WebhooksSQLiteRepository) queryDeliveries(query string, args ...any) ([]entity.Delivery, error) {
+	deliveries := make([]entity.Delivery, 0)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		delivery, err := scanIntoDelivery(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func buildDeliveryWhere(filter DeliveryFilter) (string, []any) {
+	conditions := []string{"user_id = ?"}
+	args := []any{filter.UserID}
+
+	if filter.WebhookID != "" {
+		conditions = append(conditions, "webhook_id = ?")
+		args = append(args, filter.WebhookID)
+	}
+
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, string(filter.Status))
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func expectAffectedWebhook(res sql.Result, id string) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrWebhookNotFound, id)
+	}
+
+	return nil
+}
+
+// formatTimestamp formats t as RFC 3339 in UTC, which sorts correctly as
+// text.
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatOptionalTimestamp(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return formatTimestamp(*t)
+}
+
+func parseNullableTimestamp(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func scanIntoWebhook(scan func(dest ...any) error) (*entity.Webhook, error) {
+	var (
+		id     string
+		userID int64
+		url    string
+		secret string
+		active bool
+	)
+
+	if err := scan(&id, &userID, &url, &secret, &active); err != nil {
+		return nil, err
+	}
+
+	return entity.RestoreWebhook(id, userID, url, secret, active), nil
+}
+
+func scanIntoDelivery(scan func(dest ...any) error) (*entity.Delivery, error) {
+	var (
+		id             string
+		userID         int64
+		webhookID      string
+		event          string
+		payload        string
+		status         string
+		attempts       int
+		lastStatusCode int
+		lastError      string
+		createdAt      string
+		nextAttemptAt  sql.NullString
+		deliveredAt    sql.NullString
+	)
+
+	err := scan(&id, &userID, &webhookID, &event, &payload, &status, &attempts, &lastStatusCode, &lastError, &createdAt, &nextAttemptAt, &deliveredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := parseNullableTimestamp(nextAttemptAt)
+	if err != nil {
+		return nil, err
+	}
+
+	delivered, err := parseNullableTimestamp(deliveredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.RestoreDelivery(
+		id, userID, webhookID, event, []byte(payload),
+		entity.DeliveryStatus(status), attempts, lastStatusCode, lastError,
+		created, next, delivered,
+	), nil
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooksSQLiteRepository_CRUD(t *testing.T) {
+	repo := NewWebhooksSQLiteRepository(newTestDB(t))
+
+	webhook, err := entity.NewWebhook(1, "https://example.com/hook")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*webhook))
+
+	found, err := repo.FindByID(1, webhook.ID())
+	require.NoError(t, err)
+	assert.Equal(t, webhook, found)
+
+	_, err = repo.FindByID(2, webhook.ID())
+	assert.ErrorIs(t, err, ErrWebhookNotFound)
+
+	require.NoError(t, found.SetURL("https://example.com/other"))
+	found.SetActive(false)
+	require.NoError(t, repo.Update(*found))
+
+	webhooks, err := repo.FindAll(1)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, "https://example.com/other", webhooks[0].URL())
+	assert.False(t, webhooks[0].Active())
+	assert.Equal(t, webhook.Secret(), webhooks[0].Secret(), "Updates should keep the secret")
+
+	require.NoError(t, repo.SaveDelivery(*entity.NewDelivery(*webhook, "test", []byte(`{}`), time.Now())))
+
+	assert.ErrorIs(t, repo.Delete(2, webhook.ID()), ErrWebhookNotFound)
+	require.NoError(t, repo.Delete(1, webhook.ID()))
+	_, err = repo.FindByID(1, webhook.ID())
+	assert.ErrorIs(t, err, ErrWebhookNotFound)
+
+	total, err := repo.CountDeliveries(DeliveryFilter{UserID: 1})
+	require.NoError(t, err)
+	assert.Zero(t, total, "Deleting a webhook should delete its deliveries")
+}
+
+func TestWebhooksSQLiteRepository_Deliveries(t *testing.T) {
+	repo := NewWebhooksSQLiteRepository(newTestDB(t))
+
+	webhook, err := entity.NewWebhook(1, "https://example.com/hook")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(*webhook))
+
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+
+	first := entity.NewDelivery(*webhook, "budget.threshold_reached", []byte(`{"threshold":80}`), now)
+	second := entity.NewDelivery(*webhook, "budget.threshold_reached", []byte(`{"threshold":100}`), now.Add(time.Minute))
+	require.NoError(t, repo.SaveDelivery(*first))
+	require.NoError(t, repo.SaveDelivery(*second))
+
+	due, err := repo.FindDueDeliveries(now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, first, &due[0])
+
+	first.Fail(500, "500 Internal Server Error", now.Add(time.Minute))
+	require.NoError(t, repo.UpdateDelivery(*first))
+
+	due, err = repo.FindDueDeliveries(now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1, "The failed delivery should wait for its retry")
+	assert.Equal(t, second.ID(), due[0].ID())
+
+	second.Succeed(200, now.Add(time.Minute))
+	require.NoError(t, repo.UpdateDelivery(*second))
+
+	due, err = repo.FindDueDeliveries(now.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, first, &due[0])
+
+	delivered, err := repo.FindDeliveries(DeliveryFilter{UserID: 1, WebhookID: webhook.ID(), Status: entity.DeliveryDelivered})
+	require.NoError(t, err)
+	require.Len(t, delivered, 1)
+	assert.Equal(t, second, &delivered[0])
+
+	all, err := repo.FindDeliveries(DeliveryFilter{UserID: 1, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, second.ID(), all[0].ID(), "Newest deliveries should come first")
+
+	total, err := repo.CountDeliveries(DeliveryFilter{UserID: 2})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
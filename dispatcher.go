@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/usecase"
+)
+
+// runDispatcher sends the pending webhook deliveries right away and then
+// every interval, until ctx is cancelled. Each request is bounded by
+// timeout, and only goes to a private address when allowPrivate is set.
+func runDispatcher(ctx context.Context, store *data.Store, interval, timeout time.Duration, allowPrivate bool) {
+	uc := usecase.NewDeliverUseCase(*store, usecase.NewWebhookClient(timeout, allowPrivate))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		attempted, err := uc.Execute(ctx, time.Now())
+		if err != nil {
+			log.Print("Failed to send webhook deliveries: ", err)
+		}
+		if attempted > 0 {
+			log.Printf("Attempted %d webhook deliveries", attempted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
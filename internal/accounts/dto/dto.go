@@ -0,0 +1,50 @@
+package dto
+
+import "github.com/MarioGN/finance-manager-api/pkg/money"
+
+type AccountDTO struct {
+	ID             string       `json:"id,omitempty"`
+	Name           string       `json:"name"`
+	Kind           string       `json:"kind"`
+	Currency       string       `json:"currency"`
+	OpeningBalance money.Amount `json:"opening_balance"`
+	Balance        money.Amount `json:"balance"`
+}
+
+type LedgerQueryDTO struct {
+	From string `query:"from"`
+	To   string `query:"to"`
+}
+
+// LedgerEntryDTO is one movement on an account. Amount is negative for
+// money leaving the account and Balance is the running balance after it.
+type LedgerEntryDTO struct {
+	ID          string       `json:"id"`
+	Date        string       `json:"date"`
+	Kind        string       `json:"kind"`
+	Description string       `json:"description"`
+	Amount      money.Amount `json:"amount"`
+	Balance     money.Amount `json:"balance"`
+}
+
+type LedgerDTO struct {
+	AccountID      string           `json:"account_id"`
+	OpeningBalance money.Amount     `json:"opening_balance"`
+	ClosingBalance money.Amount     `json:"closing_balance"`
+	Entries        []LedgerEntryDTO `json:"entries"`
+}
+
+type TransferDTO struct {
+	ID            string       `json:"id,omitempty"`
+	FromAccountID string       `json:"from_account_id"`
+	ToAccountID   string       `json:"to_account_id"`
+	Amount        money.Amount `json:"amount"`
+	Date          string       `json:"date"`
+	Description   string       `json:"description"`
+}
+
+type TransferQueryDTO struct {
+	AccountID string `query:"account_id"`
+	From      string `query:"from"`
+	To        string `query:"to"`
+}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/google/uuid"
+)
+
+const maxNameLength = 64
+
+type AccountKind string
+
+const (
+	CheckingAccount   AccountKind = "checking"
+	SavingsAccount    AccountKind = "savings"
+	CreditCardAccount AccountKind = "credit_card"
+	CashAccount       AccountKind = "cash"
+)
+
+func (k AccountKind) IsValid() bool {
+	switch k {
+	case CheckingAccount, SavingsAccount, CreditCardAccount, CashAccount:
+		return true
+	default:
+		return false
+	}
+}
+
+type Account struct {
+	id             string
+	userID         int64
+	name           string
+	kind           AccountKind
+	currency       string
+	openingBalance int64
+}
+
+// NewAccount creates an account. The opening balance is in minor units and
+// may be negative, e.g. for a credit card that already carries debt.
+func NewAccount(userID int64, name string, kind AccountKind, currency string, openingBalance int64) (*Account, error) {
+	if userID <= 0 {
+		return nil, errors.New("account must belong to a user")
+	}
+
+	a := &Account{
+		id:             uuid.New().String(),
+		userID:         userID,
+		openingBalance: openingBalance,
+	}
+
+	if err := a.SetName(name); err != nil {
+		return nil, err
+	}
+
+	if err := a.SetKind(kind); err != nil {
+		return nil, err
+	}
+
+	code, err := money.ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	a.currency = code
+
+	return a, nil
+}
+
+func (a *Account) SetName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if len(name) > maxNameLength {
+		return errors.New("name must be at most 64 characters long")
+	}
+	a.name = name
+	return nil
+}
+
+func (a *Account) SetKind(kind AccountKind) error {
+	if !kind.IsValid() {
+		return errors.New("kind must be checking, savings, credit_card or cash")
+	}
+	a.kind = kind
+	return nil
+}
+
+func (a *Account) SetOpeningBalance(openingBalance int64) {
+	a.openingBalance = openingBalance
+}
+
+// ToDTO renders the account with the given current balance.
+func (a *Account) ToDTO(balance int64) *dto.AccountDTO {
+	return &dto.AccountDTO{
+		ID:             a.id,
+		Name:           a.name,
+		Kind:           string(a.kind),
+		Currency:       a.currency,
+		OpeningBalance: money.Amount(a.openingBalance),
+		Balance:        money.Amount(balance),
+	}
+}
+
+func (a *Account) ID() string {
+	return a.id
+}
+
+func (a *Account) UserID() int64 {
+	return a.userID
+}
+
+func (a *Account) Name() string {
+	return a.name
+}
+
+func (a *Account) Kind() AccountKind {
+	return a.kind
+}
+
+func (a *Account) Currency() string {
+	return a.currency
+}
+
+func (a *Account) OpeningBalance() int64 {
+	return a.openingBalance
+}
+
+func (a *Account) SetID(id string) {
+	a.id = id
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   int64
+		accName  string
+		kind     AccountKind
+		currency string
+		wantErr  bool
+	}{
+		{name: "Valid checking account", userID: 1, accName: "Main", kind: CheckingAccount, currency: "eur"},
+		{name: "Valid credit card", userID: 1, accName: "Visa", kind: CreditCardAccount, currency: "USD"},
+		{name: "Missing user", userID: 0, accName: "Main", kind: CheckingAccount, currency: "EUR", wantErr: true},
+		{name: "Blank name", userID: 1, accName: "  ", kind: CheckingAccount, currency: "EUR", wantErr: true},
+		{name: "Unknown kind", userID: 1, accName: "Main", kind: "brokerage", currency: "EUR", wantErr: true},
+		{name: "Bad currency", userID: 1, accName: "Main", kind: CashAccount, currency: "EURO", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := NewAccount(tt.userID, tt.accName, tt.kind, tt.currency, -5000)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, account)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Regexp(t, "^[A-Z]{3}$", account.Currency())
+			assert.Equal(t, int64(-5000), account.OpeningBalance(), "Opening balances may be negative")
+		})
+	}
+}
+
+func TestNewTransfer(t *testing.T) {
+	date := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewTransfer(1, "a", "b", 1000, date, " Pay off card ")
+	assert.NoError(t, err)
+
+	_, err = NewTransfer(1, "a", "a", 1000, date, "")
+	assert.Error(t, err, "Transfers need two different accounts")
+
+	_, err = NewTransfer(1, "a", "", 1000, date, "")
+	assert.Error(t, err)
+
+	_, err = NewTransfer(1, "a", "b", 0, date, "")
+	assert.Error(t, err)
+
+	_, err = NewTransfer(1, "a", "b", 1000, time.Time{}, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Transfer moves money between two of a user's accounts. Transfers change
+// account balances but are neither income nor spending.
+type Transfer struct {
+	id            string
+	userID        int64
+	fromAccountID string
+	toAccountID   string
+	amount        int64
+	date          time.Time
+	description   string
+}
+
+func NewTransfer(userID int64, fromAccountID, toAccountID string, amount int64, date time.Time, description string) (*Transfer, error) {
+	if userID <= 0 {
+		return nil, errors.New("transfer must belong to a user")
+	}
+
+	if fromAccountID == "" || toAccountID == "" {
+		return nil, errors.New("both accounts are required")
+	}
+
+	if fromAccountID == toAccountID {
+		return nil, errors.New("cannot transfer to the same account")
+	}
+
+	if amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+
+	if date.IsZero() {
+		return nil, errors.New("date must be a valid date")
+	}
+
+	return &Transfer{
+		id:            uuid.New().String(),
+		userID:        userID,
+		fromAccountID: fromAccountID,
+		toAccountID:   toAccountID,
+		amount:        amount,
+		date:          date,
+		description:   strings.TrimSpace(description),
+	}, nil
+}
+
+func (t *Transfer) ToDTO() *dto.TransferDTO {
+	return &dto.TransferDTO{
+		ID:            t.id,
+		FromAccountID: t.fromAccountID,
+		ToAccountID:   t.toAccountID,
+		Amount:        money.Amount(t.amount),
+		Date:          t.date.Format("2006-01-02"),
+		Description:   t.description,
+	}
+}
+
+func (t *Transfer) ID() string {
+	return t.id
+}
+
+func (t *Transfer) UserID() int64 {
+	return t.userID
+}
+
+func (t *Transfer) FromAccountID() string {
+	return t.fromAccountID
+}
+
+func (t *Transfer) ToAccountID() string {
+	return t.toAccountID
+}
+
+func (t *Transfer) Amount() int64 {
+	return t.amount
+}
+
+func (t *Transfer) Date() time.Time {
+	return t.date
+}
+
+func (t *Transfer) Description() string {
+	return t.description
+}
+
+func (t *Transfer) SetID(id string) {
+	t.id = id
+}
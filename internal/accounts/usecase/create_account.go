@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidAccount = appErrors.Validation("invalid account")
+
+type CreateAccountUseCase struct {
+	store data.Store
+}
+
+func NewCreateAccountUseCase(store data.Store) *CreateAccountUseCase {
+	return &CreateAccountUseCase{store: store}
+}
+
+func (uc *CreateAccountUseCase) Execute(userID int64, input dto.AccountDTO) (result *dto.AccountDTO, err error) {
+	account, err := entity.NewAccount(userID, input.Name, entity.AccountKind(input.Kind), input.Currency, int64(input.OpeningBalance))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAccount, err)
+	}
+
+	if err := uc.store.Accounts.Save(*account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	return account.ToDTO(account.OpeningBalance()), nil
+}
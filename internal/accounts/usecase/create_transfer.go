@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidTransfer = appErrors.Validation("invalid transfer")
+
+type CreateTransferUseCase struct {
+	store data.Store
+}
+
+func NewCreateTransferUseCase(store data.Store) *CreateTransferUseCase {
+	return &CreateTransferUseCase{store: store}
+}
+
+// Execute moves money between two of the user's accounts. Both accounts must
+// hold the same currency.
+func (uc *CreateTransferUseCase) Execute(userID int64, input dto.TransferDTO) (result *dto.TransferDTO, err error) {
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: date must be a YYYY-MM-DD date", ErrInvalidTransfer)
+	}
+
+	transfer, err := entity.NewTransfer(userID, input.FromAccountID, input.ToAccountID, int64(input.Amount), date, input.Description)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTransfer, err)
+	}
+
+	from, err := findTransferAccount(uc.store, userID, input.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := findTransferAccount(uc.store, userID, input.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if from.Currency() != to.Currency() {
+		return nil, fmt.Errorf("%w: accounts hold different currencies (%s and %s)", ErrInvalidTransfer, from.Currency(), to.Currency())
+	}
+
+	if err := uc.store.Transfers.Save(*transfer); err != nil {
+		return nil, fmt.Errorf("failed to save transfer: %w", err)
+	}
+
+	return transfer.ToDTO(), nil
+}
+
+func findTransferAccount(store data.Store, userID int64, id string) (*entity.Account, error) {
+	account, err := store.Accounts.FindByID(userID, id)
+	if errors.Is(err, data.ErrAccountNotFound) {
+		return nil, fmt.Errorf("%w: account %s does not exist", ErrInvalidTransfer, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+
+	return account, nil
+}
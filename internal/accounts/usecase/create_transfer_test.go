@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAccountRepository implements data.AccountRepository in memory for testing
+type MockAccountRepository struct {
+	data.AccountRepository
+
+	accounts map[string]entity.Account
+}
+
+func (m *MockAccountRepository) FindByID(userID int64, id string) (*entity.Account, error) {
+	a, ok := m.accounts[id]
+	if !ok || a.UserID() != userID {
+		return nil, fmt.Errorf("%w: %s", data.ErrAccountNotFound, id)
+	}
+	return &a, nil
+}
+
+// MockTransferRepository implements data.TransferRepository for testing
+type MockTransferRepository struct {
+	data.TransferRepository
+
+	saved *entity.Transfer
+}
+
+func (m *MockTransferRepository) Save(transfer entity.Transfer) error {
+	m.saved = &transfer
+	return nil
+}
+
+func TestCreateTransfer(t *testing.T) {
+	newAccount := func(userID int64, name, currency string) *entity.Account {
+		a, err := entity.NewAccount(userID, name, entity.CheckingAccount, currency, 0)
+		require.NoError(t, err)
+		return a
+	}
+
+	checking := newAccount(1, "Checking", "EUR")
+	savings := newAccount(1, "Savings", "EUR")
+	dollars := newAccount(1, "Dollars", "USD")
+	foreign := newAccount(2, "Not mine", "EUR")
+
+	accounts := &MockAccountRepository{accounts: map[string]entity.Account{}}
+	for _, a := range []*entity.Account{checking, savings, dollars, foreign} {
+		accounts.accounts[a.ID()] = *a
+	}
+
+	tests := []struct {
+		name    string
+		to      string
+		amount  int64
+		wantErr error
+	}{
+		{name: "Same currency", to: savings.ID(), amount: 5000},
+		{name: "Different currency", to: dollars.ID(), amount: 5000, wantErr: ErrInvalidTransfer},
+		{name: "Other user's account", to: foreign.ID(), amount: 5000, wantErr: ErrInvalidTransfer},
+		{name: "Same account", to: checking.ID(), amount: 5000, wantErr: ErrInvalidTransfer},
+		{name: "Zero amount", to: savings.ID(), amount: 0, wantErr: ErrInvalidTransfer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfers := &MockTransferRepository{}
+			uc := NewCreateTransferUseCase(data.Store{Accounts: accounts, Transfers: transfers})
+
+			result, err := uc.Execute(1, dto.TransferDTO{
+				FromAccountID: checking.ID(),
+				ToAccountID:   tt.to,
+				Amount:        money.Amount(tt.amount),
+				Date:          "2026-04-01",
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, transfers.saved, "Invalid transfers must not be saved")
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, transfers.saved)
+			assert.Equal(t, result.ID, transfers.saved.ID())
+		})
+	}
+}
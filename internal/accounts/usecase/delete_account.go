@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+)
+
+type DeleteAccountUseCase struct {
+	store data.Store
+}
+
+func NewDeleteAccountUseCase(store data.Store) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{store: store}
+}
+
+// Execute deletes an account that nothing refers to any more.
+func (uc *DeleteAccountUseCase) Execute(userID int64, id string) error {
+	if err := uc.store.Accounts.Delete(userID, id); err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	return nil
+}
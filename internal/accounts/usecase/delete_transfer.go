@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+)
+
+type DeleteTransferUseCase struct {
+	store data.Store
+}
+
+func NewDeleteTransferUseCase(store data.Store) *DeleteTransferUseCase {
+	return &DeleteTransferUseCase{store: store}
+}
+
+func (uc *DeleteTransferUseCase) Execute(userID int64, id string) error {
+	if err := uc.store.Transfers.Delete(userID, id); err != nil {
+		return fmt.Errorf("failed to delete transfer: %w", err)
+	}
+
+	return nil
+}
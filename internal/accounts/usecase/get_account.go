@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+)
+
+type GetAccountUseCase struct {
+	store data.Store
+}
+
+func NewGetAccountUseCase(store data.Store) *GetAccountUseCase {
+	return &GetAccountUseCase{store: store}
+}
+
+func (uc *GetAccountUseCase) Execute(userID int64, id string) (result *dto.AccountDTO, err error) {
+	account, err := uc.store.Accounts.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account by ID: %w", err)
+	}
+
+	balance, err := uc.store.Accounts.Balance(userID, id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute account balance: %w", err)
+	}
+
+	return account.ToDTO(balance), nil
+}
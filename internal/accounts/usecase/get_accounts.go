@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+)
+
+type GetAccountsUseCase struct {
+	store data.Store
+}
+
+func NewGetAccountsUseCase(store data.Store) *GetAccountsUseCase {
+	return &GetAccountsUseCase{store: store}
+}
+
+func (uc *GetAccountsUseCase) Execute(userID int64) (result []dto.AccountDTO, err error) {
+	accounts, err := uc.store.Accounts.FindAll(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	result = make([]dto.AccountDTO, 0, len(accounts))
+	for _, a := range accounts {
+		result = append(result, *a.Account.ToDTO(a.Balance))
+	}
+
+	return result, nil
+}
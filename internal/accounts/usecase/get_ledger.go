@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+var ErrInvalidLedgerQuery = appErrors.Validation("invalid ledger query")
+
+type GetLedgerUseCase struct {
+	store data.Store
+}
+
+func NewGetLedgerUseCase(store data.Store) *GetLedgerUseCase {
+	return &GetLedgerUseCase{store: store}
+}
+
+// Execute lists the movements on an account in date order with the running
+// balance after each of them.
+func (uc *GetLedgerUseCase) Execute(userID int64, accountID string, query dto.LedgerQueryDTO) (result *dto.LedgerDTO, err error) {
+	filter := data.LedgerFilter{UserID: userID, AccountID: accountID}
+
+	if filter.From, err = parseOptionalDate(query.From); err != nil {
+		return nil, fmt.Errorf("%w: from must be a YYYY-MM-DD date", ErrInvalidLedgerQuery)
+	}
+
+	if filter.To, err = parseOptionalDate(query.To); err != nil {
+		return nil, fmt.Errorf("%w: to must be a YYYY-MM-DD date", ErrInvalidLedgerQuery)
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, fmt.Errorf("%w: from must not be after to", ErrInvalidLedgerQuery)
+	}
+
+	opening, err := uc.store.Accounts.Balance(userID, accountID, filter.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute account balance: %w", err)
+	}
+
+	entries, err := uc.store.Accounts.Ledger(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account ledger: %w", err)
+	}
+
+	result = &dto.LedgerDTO{
+		AccountID:      accountID,
+		OpeningBalance: money.Amount(opening),
+		ClosingBalance: money.Amount(opening),
+		Entries:        make([]dto.LedgerEntryDTO, 0, len(entries)),
+	}
+
+	for _, e := range entries {
+		result.Entries = append(result.Entries, dto.LedgerEntryDTO{
+			ID:          e.ID,
+			Date:        e.Date.Format("2006-01-02"),
+			Kind:        string(e.Kind),
+			Description: e.Description,
+			Amount:      money.Amount(e.Amount),
+			Balance:     money.Amount(e.Balance),
+		})
+		result.ClosingBalance = money.Amount(e.Balance)
+	}
+
+	return result, nil
+}
+
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+)
+
+type GetTransferUseCase struct {
+	store data.Store
+}
+
+func NewGetTransferUseCase(store data.Store) *GetTransferUseCase {
+	return &GetTransferUseCase{store: store}
+}
+
+func (uc *GetTransferUseCase) Execute(userID int64, id string) (result *dto.TransferDTO, err error) {
+	transfer, err := uc.store.Transfers.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transfer by ID: %w", err)
+	}
+
+	return transfer.ToDTO(), nil
+}
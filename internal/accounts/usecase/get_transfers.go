@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+)
+
+type GetTransfersUseCase struct {
+	store data.Store
+}
+
+func NewGetTransfersUseCase(store data.Store) *GetTransfersUseCase {
+	return &GetTransfersUseCase{store: store}
+}
+
+func (uc *GetTransfersUseCase) Execute(userID int64, query dto.TransferQueryDTO) (result []dto.TransferDTO, err error) {
+	filter := data.TransferFilter{UserID: userID, AccountID: query.AccountID}
+
+	if filter.From, err = parseOptionalDate(query.From); err != nil {
+		return nil, fmt.Errorf("%w: from must be a YYYY-MM-DD date", ErrInvalidLedgerQuery)
+	}
+
+	if filter.To, err = parseOptionalDate(query.To); err != nil {
+		return nil, fmt.Errorf("%w: to must be a YYYY-MM-DD date", ErrInvalidLedgerQuery)
+	}
+
+	transfers, err := uc.store.Transfers.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transfers: %w", err)
+	}
+
+	result = make([]dto.TransferDTO, 0, len(transfers))
+	for _, t := range transfers {
+		result = append(result, *t.ToDTO())
+	}
+
+	return result, nil
+}
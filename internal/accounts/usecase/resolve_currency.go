@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+// ErrInvalidCurrency is a requested currency that is not an ISO 4217 code,
+// or not the currency of the account the amount is on.
+var ErrInvalidCurrency = appErrors.Validation("invalid currency")
+
+// ResolveCurrency picks the currency an amount is recorded in. Amounts on an
+// account always use the account's currency, which must be one of the
+// user's and fails with data.ErrAccountNotFound otherwise. Without an
+// account the requested currency applies, then current, then the user's
+// base currency.
+func ResolveCurrency(store data.Store, userID int64, accountID, requested, current string) (string, error) {
+	if requested != "" {
+		currency, err := money.ParseCurrency(requested)
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", ErrInvalidCurrency, err)
+		}
+		requested = currency
+	}
+
+	if accountID != "" {
+		account, err := store.Accounts.FindByID(userID, accountID)
+		if err != nil {
+			return "", fmt.Errorf("failed to find account: %w", err)
+		}
+
+		if requested != "" && requested != account.Currency() {
+			return "", fmt.Errorf("%w: account %s is kept in %s", ErrInvalidCurrency, account.Name(), account.Currency())
+		}
+
+		return account.Currency(), nil
+	}
+
+	if requested != "" {
+		return requested, nil
+	}
+
+	if current != "" {
+		return current, nil
+	}
+
+	user, err := store.Users.FindByID(userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return user.BaseCurrency(), nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	authEntity "github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUserRepository has users whose base currency is CHF
+type MockUserRepository struct {
+	repository.UserRepository
+}
+
+func (m *MockUserRepository) FindByID(id int64) (*authEntity.UserAccount, error) {
+	user := authEntity.RestoreUserAccount(id, "user@example.com", "")
+	if err := user.SetBaseCurrency("CHF"); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func TestResolveCurrency(t *testing.T) {
+	dollars, err := entity.NewAccount(1, "Dollars", entity.CheckingAccount, "USD", 0)
+	require.NoError(t, err)
+
+	store := data.Store{
+		Accounts: &MockAccountRepository{accounts: map[string]entity.Account{dollars.ID(): *dollars}},
+		Users:    &MockUserRepository{},
+	}
+
+	tests := []struct {
+		name        string
+		accountID   string
+		requested   string
+		current     string
+		expected    string
+		expectedErr error
+	}{
+		{name: "The account's currency wins", accountID: dollars.ID(), current: "EUR", expected: "USD"},
+		{name: "Requested on the account", accountID: dollars.ID(), requested: "usd", expected: "USD"},
+		{name: "Requested without an account", requested: "eur", current: "GBP", expected: "EUR"},
+		{name: "Current", current: "GBP", expected: "GBP"},
+		{name: "Base currency", expected: "CHF"},
+		{name: "Differing from the account", accountID: dollars.ID(), requested: "EUR", expectedErr: ErrInvalidCurrency},
+		{name: "Not a currency code", requested: "euro", expectedErr: ErrInvalidCurrency},
+		{name: "Unknown account", accountID: "missing", expectedErr: data.ErrAccountNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currency, err := ResolveCurrency(store, 1, tt.accountID, tt.requested, tt.current)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, currency)
+		})
+	}
+}
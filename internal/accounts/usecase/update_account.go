@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+)
+
+type UpdateAccountUseCase struct {
+	store data.Store
+}
+
+func NewUpdateAccountUseCase(store data.Store) *UpdateAccountUseCase {
+	return &UpdateAccountUseCase{store: store}
+}
+
+// Execute updates the account name, kind and opening balance. The currency
+// is fixed once the account exists; an empty currency keeps it.
+func (uc *UpdateAccountUseCase) Execute(userID int64, id string, input dto.AccountDTO) (result *dto.AccountDTO, err error) {
+	account, err := uc.store.Accounts.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account by ID: %w", err)
+	}
+
+	if input.Currency != "" && !strings.EqualFold(input.Currency, account.Currency()) {
+		return nil, fmt.Errorf("%w: currency cannot be changed", ErrInvalidAccount)
+	}
+
+	if err := account.SetName(input.Name); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAccount, err)
+	}
+
+	if err := account.SetKind(entity.AccountKind(input.Kind)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAccount, err)
+	}
+
+	account.SetOpeningBalance(int64(input.OpeningBalance))
+
+	if err := uc.store.Accounts.Update(*account); err != nil {
+		return nil, fmt.Errorf("failed to save account: %w", err)
+	}
+
+	balance, err := uc.store.Accounts.Balance(userID, id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute account balance: %w", err)
+	}
+
+	return account.ToDTO(balance), nil
+}
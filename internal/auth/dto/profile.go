@@ -0,0 +1,11 @@
+package dto
+
+type ProfileDTO struct {
+	ID           int64  `json:"id"`
+	Email        string `json:"email"`
+	BaseCurrency string `json:"base_currency"`
+}
+
+type UpdateProfileDTO struct {
+	BaseCurrency string `json:"base_currency"`
+}
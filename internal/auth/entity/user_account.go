@@ -3,35 +3,51 @@ package entity
 import (
 	"fmt"
 
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
 	"golang.org/x/crypto/bcrypt"
 )
 
+var (
+	ErrEmptyEmail       = appErrors.Validation("email cannot be empty")
+	ErrPasswordTooShort = appErrors.Validation("password must be at least 6 characters long")
+)
+
 type UserAccount struct {
 	id           int64
 	email        string
 	passwordHash string
+	baseCurrency string
 }
 
 func NewUserAccount(email, password string) (*UserAccount, error) {
 	if email == "" {
-		return nil, fmt.Errorf("email cannot be empty")
-	}
-
-	if len(password) < 6 {
-		return nil, fmt.Errorf("password must be at least 6 characters long")
+		return nil, ErrEmptyEmail
 	}
 
-	pw, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	pw, err := hashPassword(password)
 	if err != nil {
-		return nil, fmt.Errorf("failed to hash password: %w", err)
+		return nil, err
 	}
 
 	return &UserAccount{
 		email:        email,
-		passwordHash: string(pw),
+		passwordHash: pw,
+		baseCurrency: money.DefaultCurrency,
 	}, nil
 }
 
+// RestoreUserAccount rebuilds an account from persisted data without
+// re-hashing the password.
+func RestoreUserAccount(id int64, email, passwordHash string) *UserAccount {
+	return &UserAccount{
+		id:           id,
+		email:        email,
+		passwordHash: passwordHash,
+		baseCurrency: money.DefaultCurrency,
+	}
+}
+
 func (u *UserAccount) ID() int64 {
 	return u.id
 }
@@ -44,6 +60,42 @@ func (u *UserAccount) PasswordHash() string {
 	return u.passwordHash
 }
 
+// BaseCurrency is the currency reports are converted into.
+func (u *UserAccount) BaseCurrency() string {
+	return u.baseCurrency
+}
+
+func (u *UserAccount) SetBaseCurrency(currency string) error {
+	code, err := money.ParseCurrency(currency)
+	if err != nil {
+		return err
+	}
+	u.baseCurrency = code
+	return nil
+}
+
 func (u *UserAccount) ValidatePassword(password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(u.passwordHash), []byte(password))
 }
+
+func (u *UserAccount) SetPassword(password string) error {
+	pw, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	u.passwordHash = pw
+	return nil
+}
+
+func hashPassword(password string) (string, error) {
+	if len(password) < 6 {
+		return "", ErrPasswordTooShort
+	}
+
+	pw, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return string(pw), nil
+}
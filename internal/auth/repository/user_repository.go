@@ -1,7 +1,32 @@
 package repository
 
-import "github.com/MarioGN/finance-manager-api/internal/auth/entity"
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrUserNotFound = appErrors.NotFound("user not found")
+
+// EmailAlreadyRegisteredError is returned by Save when another account
+// already uses the same email address.
+type EmailAlreadyRegisteredError struct {
+	Email string
+}
+
+func (e *EmailAlreadyRegisteredError) Error() string {
+	return fmt.Sprintf("email %s is already registered", e.Email)
+}
+
+func (e *EmailAlreadyRegisteredError) Kind() appErrors.Kind {
+	return appErrors.KindConflict
+}
 
 type UserRepository interface {
 	Save(user entity.UserAccount) (int64, error)
+	FindByEmail(email string) (*entity.UserAccount, error)
+	FindByID(id int64) (*entity.UserAccount, error)
+	UpdatePassword(id int64, passwordHash string) error
+	UpdateBaseCurrency(id int64, currency string) error
 }
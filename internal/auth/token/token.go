@@ -0,0 +1,69 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const DefaultTTL = 24 * time.Hour
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Manager issues and verifies HMAC-SHA256 signed JWT access tokens whose
+// subject is the user ID.
+type Manager struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewManager(secret string, ttl time.Duration) (*Manager, error) {
+	if len(secret) < 32 {
+		return nil, errors.New("token secret must be at least 32 characters long")
+	}
+
+	if ttl <= 0 {
+		return nil, errors.New("token ttl must be greater than zero")
+	}
+
+	return &Manager{secret: []byte(secret), ttl: ttl}, nil
+}
+
+func (m *Manager) Issue(userID int64) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.ttl)
+
+	claims := jwt.RegisteredClaims{
+		Subject:   strconv.FormatInt(userID, 10),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return signed, expiresAt, nil
+}
+
+func (m *Manager) Verify(tokenString string) (int64, error) {
+	var claims jwt.RegisteredClaims
+
+	_, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (any, error) {
+		return m.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, fmt.Errorf("%w: invalid subject", ErrInvalidToken)
+	}
+
+	return userID, nil
+}
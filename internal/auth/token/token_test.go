@@ -0,0 +1,64 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestNewManager_Validation(t *testing.T) {
+	_, err := NewManager("short", time.Hour)
+	assert.Error(t, err, "Short secrets should be rejected")
+
+	_, err = NewManager(testSecret, 0)
+	assert.Error(t, err, "Non-positive TTL should be rejected")
+}
+
+func TestManager_IssueAndVerify(t *testing.T) {
+	m, err := NewManager(testSecret, time.Hour)
+	require.NoError(t, err)
+
+	signed, expiresAt, err := m.Issue(42)
+	require.NoError(t, err)
+	assert.NotEmpty(t, signed)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	userID, err := m.Verify(signed)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), userID)
+}
+
+func TestManager_VerifyRejectsInvalidTokens(t *testing.T) {
+	m, err := NewManager(testSecret, time.Hour)
+	require.NoError(t, err)
+
+	other, err := NewManager("fedcba9876543210fedcba9876543210", time.Hour)
+	require.NoError(t, err)
+
+	foreign, _, err := other.Issue(42)
+	require.NoError(t, err)
+
+	expiredManager := &Manager{secret: []byte(testSecret), ttl: -time.Minute}
+	expired, _, err := expiredManager.Issue(42)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "Garbage", token: "not-a-token"},
+		{name: "Signed with another secret", token: foreign},
+		{name: "Expired", token: expired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := m.Verify(tt.token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
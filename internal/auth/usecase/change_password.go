@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+)
+
+func ChangePassword(r repository.UserRepository, userID int64, input dto.ChangePasswordDTO) error {
+	user, err := r.FindByID(userID)
+	if err != nil {
+		return fmt.Errorf("failed to find user account: %w", err)
+	}
+
+	if err := user.ValidatePassword(input.CurrentPassword); err != nil {
+		return ErrInvalidCredentials
+	}
+
+	if err := user.SetPassword(input.NewPassword); err != nil {
+		return fmt.Errorf("failed to set new password: %w", userField(err, "/new_password"))
+	}
+
+	if err := r.UpdatePassword(user.ID(), user.PasswordHash()); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	return nil
+}
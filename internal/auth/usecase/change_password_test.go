@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
+	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangePassword_Successful(t *testing.T) {
+	mockRepo := NewMockUserRepository()
+	mockRepo.SetFindByIDReturnValues(newStoredUser(t, 3, "user@example.com", "oldPassword"), nil)
+
+	err := ChangePassword(mockRepo, 3, dto.ChangePasswordDTO{
+		CurrentPassword: "oldPassword",
+		NewPassword:     "newPassword",
+	})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, mockRepo.updatedPassword, "New password hash should be persisted")
+	updated := entity.RestoreUserAccount(3, "user@example.com", mockRepo.updatedPassword)
+	assert.NoError(t, updated.ValidatePassword("newPassword"))
+	assert.Error(t, updated.ValidatePassword("oldPassword"))
+}
+
+func TestChangePassword_Failures(t *testing.T) {
+	tests := []struct {
+		name        string
+		findErr     error
+		input       dto.ChangePasswordDTO
+		expectedErr error
+	}{
+		{
+			name:        "Wrong current password",
+			input:       dto.ChangePasswordDTO{CurrentPassword: "wrong", NewPassword: "newPassword"},
+			expectedErr: ErrInvalidCredentials,
+		},
+		{
+			name:        "New password too short",
+			input:       dto.ChangePasswordDTO{CurrentPassword: "oldPassword", NewPassword: "123"},
+			expectedErr: entity.ErrPasswordTooShort,
+		},
+		{
+			name:        "Unknown user",
+			findErr:     repository.ErrUserNotFound,
+			input:       dto.ChangePasswordDTO{CurrentPassword: "oldPassword", NewPassword: "newPassword"},
+			expectedErr: repository.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := NewMockUserRepository()
+			if tt.findErr != nil {
+				mockRepo.SetFindByIDReturnValues(nil, tt.findErr)
+			} else {
+				mockRepo.SetFindByIDReturnValues(newStoredUser(t, 3, "user@example.com", "oldPassword"), nil)
+			}
+
+			err := ChangePassword(mockRepo, 3, tt.input)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Empty(t, mockRepo.updatedPassword, "Password should not be updated")
+		})
+	}
+}
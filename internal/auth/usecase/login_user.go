@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidCredentials = appErrors.Unauthorized("invalid email or password")
+
+type TokenIssuer interface {
+	Issue(userID int64) (token string, expiresAt time.Time, err error)
+}
+
+func LoginUser(r repository.UserRepository, issuer TokenIssuer, input dto.LoginUserDTO) (output *dto.LoginResponseDTO, err error) {
+	user, err := r.FindByEmail(input.Email)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user account: %w", err)
+	}
+
+	if err := user.ValidatePassword(input.Password); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	token, expiresAt, err := issuer.Issue(user.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue access token: %w", err)
+	}
+
+	return &dto.LoginResponseDTO{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(time.Until(expiresAt).Seconds()),
+	}, nil
+}
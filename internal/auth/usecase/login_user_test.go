@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
+	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTokenIssuer implements TokenIssuer interface for testing
+type MockTokenIssuer struct {
+	issuedFor int64
+	err       error
+}
+
+func (m *MockTokenIssuer) Issue(userID int64) (string, time.Time, error) {
+	m.issuedFor = userID
+	if m.err != nil {
+		return "", time.Time{}, m.err
+	}
+	return "signed-token", time.Now().Add(time.Hour), nil
+}
+
+func newStoredUser(t *testing.T, id int64, email, password string) *entity.UserAccount {
+	t.Helper()
+
+	user, err := entity.NewUserAccount(email, password)
+	require.NoError(t, err)
+
+	return entity.RestoreUserAccount(id, user.Email(), user.PasswordHash())
+}
+
+func TestLoginUser_SuccessfulLogin(t *testing.T) {
+	mockRepo := NewMockUserRepository()
+	mockRepo.SetFindByEmailReturnValues(newStoredUser(t, 7, "user@example.com", "strongPassword123"), nil)
+	issuer := &MockTokenIssuer{}
+
+	result, err := LoginUser(mockRepo, issuer, dto.LoginUserDTO{
+		Email:    "user@example.com",
+		Password: "strongPassword123",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "signed-token", result.AccessToken)
+	assert.Equal(t, "Bearer", result.TokenType)
+	assert.Greater(t, result.ExpiresIn, int64(0))
+	assert.Equal(t, int64(7), issuer.issuedFor, "Token should be issued for the stored user ID")
+}
+
+func TestLoginUser_InvalidCredentials(t *testing.T) {
+	tests := []struct {
+		name      string
+		storedErr error
+		password  string
+	}{
+		{
+			name:      "Unknown email",
+			storedErr: repository.ErrUserNotFound,
+			password:  "strongPassword123",
+		},
+		{
+			name:     "Wrong password",
+			password: "wrongPassword",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := NewMockUserRepository()
+			if tt.storedErr != nil {
+				mockRepo.SetFindByEmailReturnValues(nil, tt.storedErr)
+			} else {
+				mockRepo.SetFindByEmailReturnValues(newStoredUser(t, 1, "user@example.com", "strongPassword123"), nil)
+			}
+			issuer := &MockTokenIssuer{}
+
+			result, err := LoginUser(mockRepo, issuer, dto.LoginUserDTO{
+				Email:    "user@example.com",
+				Password: tt.password,
+			})
+
+			assert.ErrorIs(t, err, ErrInvalidCredentials)
+			assert.Nil(t, result)
+			assert.Zero(t, issuer.issuedFor, "Token should not be issued")
+		})
+	}
+}
+
+func TestLoginUser_RepositoryFailure(t *testing.T) {
+	mockRepo := NewMockUserRepository()
+	mockRepo.SetFindByEmailReturnValues(nil, errors.New("database connection failed"))
+
+	result, err := LoginUser(mockRepo, &MockTokenIssuer{}, dto.LoginUserDTO{
+		Email:    "user@example.com",
+		Password: "strongPassword123",
+	})
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidCredentials)
+	assert.Contains(t, err.Error(), "database connection failed")
+	assert.Nil(t, result)
+}
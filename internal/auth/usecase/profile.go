@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
+	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+func GetProfile(r repository.UserRepository, userID int64) (*dto.ProfileDTO, error) {
+	user, err := r.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user account: %w", err)
+	}
+
+	return toProfileDTO(user), nil
+}
+
+// UpdateProfile changes the user's base currency, which reports are
+// converted into.
+func UpdateProfile(r repository.UserRepository, userID int64, input dto.UpdateProfileDTO) (*dto.ProfileDTO, error) {
+	user, err := r.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user account: %w", err)
+	}
+
+	if err := user.SetBaseCurrency(input.BaseCurrency); err != nil {
+		return nil, fmt.Errorf("failed to set base currency: %w", appErrors.Field("/base_currency", err))
+	}
+
+	if err := r.UpdateBaseCurrency(user.ID(), user.BaseCurrency()); err != nil {
+		return nil, fmt.Errorf("failed to update base currency: %w", err)
+	}
+
+	return toProfileDTO(user), nil
+}
+
+func toProfileDTO(user *entity.UserAccount) *dto.ProfileDTO {
+	return &dto.ProfileDTO{
+		ID:           user.ID(),
+		Email:        user.Email(),
+		BaseCurrency: user.BaseCurrency(),
+	}
+}
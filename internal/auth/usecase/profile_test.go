@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
+	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateProfile_BaseCurrency(t *testing.T) {
+	mockRepo := NewMockUserRepository()
+	mockRepo.SetFindByIDReturnValues(entity.RestoreUserAccount(1, "user@example.com", "hash"), nil)
+
+	profile, err := GetProfile(mockRepo, 1)
+	require.NoError(t, err)
+	assert.Equal(t, money.DefaultCurrency, profile.BaseCurrency)
+
+	profile, err = UpdateProfile(mockRepo, 1, dto.UpdateProfileDTO{BaseCurrency: "usd"})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", profile.BaseCurrency)
+	assert.Equal(t, "USD", mockRepo.updatedBaseCurrency)
+
+	mockRepo.Reset()
+	mockRepo.SetFindByIDReturnValues(entity.RestoreUserAccount(1, "user@example.com", "hash"), nil)
+
+	_, err = UpdateProfile(mockRepo, 1, dto.UpdateProfileDTO{BaseCurrency: "dollars"})
+	assert.ErrorIs(t, err, money.ErrInvalidCurrency)
+	assert.Empty(t, mockRepo.updatedBaseCurrency, "Invalid currencies must not be saved")
+}
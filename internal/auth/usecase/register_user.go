@@ -1,17 +1,19 @@
 package usecase
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
 	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
 	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
 )
 
 func RegisterUser(r repository.UserRepository, input dto.RegisterUserDTO) (output *dto.RegisteredUserResponseDTO, err error) {
 	newUser, err := entity.NewUserAccount(input.Email, input.Password)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user account entity: %w", err)
+		return nil, fmt.Errorf("failed to create user account entity: %w", userField(err, "/password"))
 	}
 
 	id, err := r.Save(*newUser)
@@ -24,3 +26,15 @@ func RegisterUser(r repository.UserRepository, input dto.RegisterUserDTO) (outpu
 		Email: newUser.Email(),
 	}, nil
 }
+
+// userField marks an error of the user entity with the request value it is
+// about. passwordPointer points to the new password.
+func userField(err error, passwordPointer string) error {
+	switch {
+	case errors.Is(err, entity.ErrEmptyEmail):
+		return appErrors.Field("/email", err)
+	case errors.Is(err, entity.ErrPasswordTooShort):
+		return appErrors.Field(passwordPointer, err)
+	}
+	return err
+}
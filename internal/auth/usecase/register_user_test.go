@@ -6,6 +6,7 @@ import (
 
 	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
 	"github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -17,6 +18,17 @@ type MockUserRepository struct {
 	saveReturnError error
 	saveCalled      bool
 	savedUser       *entity.UserAccount
+
+	findByEmailUser  *entity.UserAccount
+	findByEmailError error
+
+	findByIDUser  *entity.UserAccount
+	findByIDError error
+
+	updatePasswordError error
+	updatedPassword     string
+
+	updatedBaseCurrency string
 }
 
 func NewMockUserRepository() *MockUserRepository {
@@ -29,12 +41,40 @@ func (m *MockUserRepository) Save(user entity.UserAccount) (int64, error) {
 	return m.saveReturnID, m.saveReturnError
 }
 
+func (m *MockUserRepository) FindByEmail(email string) (*entity.UserAccount, error) {
+	return m.findByEmailUser, m.findByEmailError
+}
+
+func (m *MockUserRepository) FindByID(id int64) (*entity.UserAccount, error) {
+	return m.findByIDUser, m.findByIDError
+}
+
+func (m *MockUserRepository) UpdatePassword(id int64, passwordHash string) error {
+	m.updatedPassword = passwordHash
+	return m.updatePasswordError
+}
+
+func (m *MockUserRepository) UpdateBaseCurrency(id int64, currency string) error {
+	m.updatedBaseCurrency = currency
+	return nil
+}
+
 // Helper methods for test setup
 func (m *MockUserRepository) SetSaveReturnValues(id int64, err error) {
 	m.saveReturnID = id
 	m.saveReturnError = err
 }
 
+func (m *MockUserRepository) SetFindByEmailReturnValues(user *entity.UserAccount, err error) {
+	m.findByEmailUser = user
+	m.findByEmailError = err
+}
+
+func (m *MockUserRepository) SetFindByIDReturnValues(user *entity.UserAccount, err error) {
+	m.findByIDUser = user
+	m.findByIDError = err
+}
+
 func (m *MockUserRepository) WasSaveCalled() bool {
 	return m.saveCalled
 }
@@ -48,6 +88,13 @@ func (m *MockUserRepository) Reset() {
 	m.saveReturnError = nil
 	m.saveCalled = false
 	m.savedUser = nil
+	m.findByEmailUser = nil
+	m.findByEmailError = nil
+	m.findByIDUser = nil
+	m.findByIDError = nil
+	m.updatePasswordError = nil
+	m.updatedPassword = ""
+	m.updatedBaseCurrency = ""
 }
 
 func TestRegisterUser_SuccessfulRegistration(t *testing.T) {
@@ -181,6 +228,15 @@ func TestRegisterUser_RepositorySaveFailure(t *testing.T) {
 			repositoryError: errors.New("UNIQUE constraint failed: users.email"),
 			expectedError:   "failed to save user account",
 		},
+		{
+			name: "Email already registered",
+			input: dto.RegisterUserDTO{
+				Email:    "duplicate@example.com",
+				Password: "password123",
+			},
+			repositoryError: &repository.EmailAlreadyRegisteredError{Email: "duplicate@example.com"},
+			expectedError:   "failed to save user account",
+		},
 		{
 			name: "Generic database error",
 			input: dto.RegisterUserDTO{
@@ -206,6 +262,7 @@ func TestRegisterUser_RepositorySaveFailure(t *testing.T) {
 			assert.Nil(t, result, "Result should be nil on error")
 			assert.Contains(t, err.Error(), tt.expectedError, "Error should contain expected message")
 			assert.Contains(t, err.Error(), tt.repositoryError.Error(), "Error should contain original repository error")
+			assert.ErrorIs(t, err, tt.repositoryError, "Repository error should be wrapped")
 
 			// Verify repository was called
 			assert.True(t, mockRepo.WasSaveCalled(), "Repository Save method should be called")
@@ -0,0 +1,42 @@
+package dto
+
+import "github.com/MarioGN/finance-manager-api/pkg/money"
+
+// BudgetDTO is a spending limit for one category or expense type. A budget
+// without a month applies to every month that has no budget of its own for
+// the same category or type.
+type BudgetDTO struct {
+	ID          string       `json:"id,omitempty"`
+	Month       string       `json:"month,omitempty"`
+	CategoryID  string       `json:"category_id,omitempty"`
+	ExpenseType string       `json:"expense_type,omitempty"`
+	Amount      money.Amount `json:"amount"`
+	Currency    string       `json:"currency"`
+}
+
+type BudgetStatusQueryDTO struct {
+	Month string `query:"month"`
+}
+
+// BudgetStatusDTO reports progress against a budget. Projected extrapolates
+// the spending so far at the same daily pace to the end of the month.
+type BudgetStatusDTO struct {
+	BudgetID            string       `json:"budget_id"`
+	CategoryID          string       `json:"category_id,omitempty"`
+	ExpenseType         string       `json:"expense_type,omitempty"`
+	Currency            string       `json:"currency"`
+	Limit               money.Amount `json:"limit"`
+	Spent               money.Amount `json:"spent"`
+	Remaining           money.Amount `json:"remaining"`
+	PercentUsed         float64      `json:"percent_used"`
+	Projected           money.Amount `json:"projected"`
+	OverBudget          bool         `json:"over_budget"`
+	ProjectedOverBudget bool         `json:"projected_over_budget"`
+}
+
+type BudgetStatusListDTO struct {
+	Month       string            `json:"month"`
+	DaysElapsed int               `json:"days_elapsed"`
+	DaysInMonth int               `json:"days_in_month"`
+	Items       []BudgetStatusDTO `json:"items"`
+}
@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Budget limits the spending of one category, including its subcategories,
+// or of one expense type. Month is the first day of the month the budget is
+// for, or nil when it applies to every month.
+type Budget struct {
+	id          string
+	userID      int64
+	month       *time.Time
+	categoryID  string
+	expenseType expenseEntity.ExpenseType
+	amount      int64
+	currency    string
+}
+
+func NewBudget(userID int64, month *time.Time, categoryID string, expenseType expenseEntity.ExpenseType, amount int64, currency string) (*Budget, error) {
+	if userID <= 0 {
+		return nil, errors.New("budget must belong to a user")
+	}
+
+	b := &Budget{
+		id:     uuid.New().String(),
+		userID: userID,
+	}
+
+	b.SetMonth(month)
+
+	if err := b.SetScope(categoryID, expenseType); err != nil {
+		return nil, err
+	}
+
+	if err := b.SetAmount(amount); err != nil {
+		return nil, err
+	}
+
+	if err := b.SetCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// ParseMonth parses a YYYY-MM month into its first day.
+func ParseMonth(value string) (time.Time, error) {
+	month, err := time.Parse("2006-01", value)
+	if err != nil {
+		return time.Time{}, errors.New("month must be a YYYY-MM month")
+	}
+	return month, nil
+}
+
+func (b *Budget) SetMonth(month *time.Time) {
+	if month == nil {
+		b.month = nil
+		return
+	}
+
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	b.month = &first
+}
+
+// SetScope sets what the budget covers: either a category or an expense
+// type, but not both.
+func (b *Budget) SetScope(categoryID string, expenseType expenseEntity.ExpenseType) error {
+	switch {
+	case categoryID == "" && expenseType == "":
+		return errors.New("either a category or an expense type is required")
+	case categoryID != "" && expenseType != "":
+		return errors.New("a budget covers either a category or an expense type, not both")
+	case expenseType != "" && !expenseType.IsValid():
+		return errors.New("invalid expense type")
+	}
+
+	b.categoryID = categoryID
+	b.expenseType = expenseType
+	return nil
+}
+
+func (b *Budget) SetAmount(amount int64) error {
+	if amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	b.amount = amount
+	return nil
+}
+
+func (b *Budget) SetCurrency(currency string) error {
+	code, err := money.ParseCurrency(currency)
+	if err != nil {
+		return err
+	}
+	b.currency = code
+	return nil
+}
+
+// AppliesTo reports whether the budget is for the month starting on month.
+func (b *Budget) AppliesTo(month time.Time) bool {
+	return b.month == nil || b.month.Equal(month)
+}
+
+func (b *Budget) ToDTO() *dto.BudgetDTO {
+	result := &dto.BudgetDTO{
+		ID:          b.id,
+		CategoryID:  b.categoryID,
+		ExpenseType: string(b.expenseType),
+		Amount:      money.Amount(b.amount),
+		Currency:    b.currency,
+	}
+
+	if b.month != nil {
+		result.Month = b.month.Format("2006-01")
+	}
+
+	return result
+}
+
+func (b *Budget) ID() string {
+	return b.id
+}
+
+func (b *Budget) UserID() int64 {
+	return b.userID
+}
+
+func (b *Budget) Month() *time.Time {
+	return b.month
+}
+
+func (b *Budget) CategoryID() string {
+	return b.categoryID
+}
+
+func (b *Budget) ExpenseType() expenseEntity.ExpenseType {
+	return b.expenseType
+}
+
+func (b *Budget) Amount() int64 {
+	return b.amount
+}
+
+func (b *Budget) Currency() string {
+	return b.currency
+}
+
+func (b *Budget) SetID(id string) {
+	b.id = id
+}
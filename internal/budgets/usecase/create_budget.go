@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidBudget = appErrors.Validation("invalid budget")
+
+type CreateBudgetUseCase struct {
+	store data.Store
+}
+
+func NewCreateBudgetUseCase(store data.Store) *CreateBudgetUseCase {
+	return &CreateBudgetUseCase{store: store}
+}
+
+func (uc *CreateBudgetUseCase) Execute(userID int64, input dto.BudgetDTO) (result *dto.BudgetDTO, err error) {
+	month, err := parseOptionalMonth(input.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCategory(uc.store, userID, input.CategoryID); err != nil {
+		return nil, err
+	}
+
+	currency, err := budgetCurrency(uc.store, userID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := entity.NewBudget(userID, month, input.CategoryID, expenseEntity.ExpenseType(input.ExpenseType), int64(input.Amount), currency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBudget, err)
+	}
+
+	if err := uc.store.Budgets.Save(*budget); err != nil {
+		return nil, fmt.Errorf("failed to save budget: %w", err)
+	}
+
+	return budget.ToDTO(), nil
+}
+
+func parseOptionalMonth(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	month, err := entity.ParseMonth(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBudget, err)
+	}
+
+	return &month, nil
+}
+
+func checkCategory(store data.Store, userID int64, categoryID string) error {
+	if categoryID == "" {
+		return nil
+	}
+
+	_, err := store.Categories.FindByID(userID, categoryID)
+	if errors.Is(err, data.ErrCategoryNotFound) {
+		return fmt.Errorf("%w: category %s does not exist", ErrInvalidBudget, categoryID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find category: %w", err)
+	}
+
+	return nil
+}
+
+// budgetCurrency defaults to the user's base currency.
+func budgetCurrency(store data.Store, userID int64, requested string) (string, error) {
+	if requested != "" {
+		return requested, nil
+	}
+
+	user, err := store.Users.FindByID(userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return user.BaseCurrency(), nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+)
+
+type DeleteBudgetUseCase struct {
+	store data.Store
+}
+
+func NewDeleteBudgetUseCase(store data.Store) *DeleteBudgetUseCase {
+	return &DeleteBudgetUseCase{store: store}
+}
+
+func (uc *DeleteBudgetUseCase) Execute(userID int64, id string) error {
+	if err := uc.store.Budgets.Delete(userID, id); err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+)
+
+type GetBudgetUseCase struct {
+	store data.Store
+}
+
+func NewGetBudgetUseCase(store data.Store) *GetBudgetUseCase {
+	return &GetBudgetUseCase{store: store}
+}
+
+func (uc *GetBudgetUseCase) Execute(userID int64, id string) (result *dto.BudgetDTO, err error) {
+	budget, err := uc.store.Budgets.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find budget by ID: %w", err)
+	}
+
+	return budget.ToDTO(), nil
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	exchangeRates "github.com/MarioGN/finance-manager-api/internal/exchangerates/usecase"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+var ErrInvalidBudgetQuery = appErrors.Validation("invalid budget query")
+
+var now = time.Now
+
+type GetBudgetStatusUseCase struct {
+	store data.Store
+}
+
+func NewGetBudgetStatusUseCase(store data.Store) *GetBudgetStatusUseCase {
+	return &GetBudgetStatusUseCase{store: store}
+}
+
+// Execute reports spending against every budget that applies to the month,
+// the current month by default. A budget for that specific month takes the
+// place of an every-month budget with the same category or type.
+func (uc *GetBudgetStatusUseCase) Execute(userID int64, query dto.BudgetStatusQueryDTO) (result *dto.BudgetStatusListDTO, err error) {
+	y, m, d := now().UTC().Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	month := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+
+	if query.Month != "" {
+		if month, err = entity.ParseMonth(query.Month); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBudgetQuery, err)
+		}
+	}
+
+	budgets, err := uc.store.Budgets.FindAll(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	end := month.AddDate(0, 1, -1)
+	daysInMonth := end.Day()
+
+	var elapsed int
+	switch {
+	case today.After(end):
+		elapsed = daysInMonth
+	case today.Before(month):
+		elapsed = 0
+	default:
+		elapsed = today.Day()
+	}
+
+	result = &dto.BudgetStatusListDTO{
+		Month:       month.Format("2006-01"),
+		DaysElapsed: elapsed,
+		DaysInMonth: daysInMonth,
+		Items:       make([]dto.BudgetStatusDTO, 0),
+	}
+
+	converters := make(map[string]data.CurrencyConverter)
+
+	for _, budget := range applicableBudgets(budgets, month) {
+		converter, ok := converters[budget.Currency()]
+		if !ok {
+			converter = exchangeRates.NewConverter(uc.store, userID, budget.Currency())
+			converters[budget.Currency()] = converter
+		}
+
+		spent, err := uc.store.Reports.SumExpenses(data.SpendingFilter{
+			UserID:      userID,
+			From:        month,
+			To:          end,
+			CategoryID:  budget.CategoryID(),
+			ExpenseType: budget.ExpenseType(),
+			Converter:   converter,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to sum expenses: %w", err)
+		}
+
+		result.Items = append(result.Items, budgetStatus(budget, spent, elapsed, daysInMonth))
+	}
+
+	return result, nil
+}
+
+// applicableBudgets keeps the budgets for month, letting a month-specific
+// budget override the every-month budget with the same scope.
+func applicableBudgets(budgets []entity.Budget, month time.Time) []entity.Budget {
+	specific := make(map[string]bool)
+	for _, b := range budgets {
+		if b.Month() != nil && b.AppliesTo(month) {
+			specific[b.CategoryID()+"|"+string(b.ExpenseType())] = true
+		}
+	}
+
+	applicable := make([]entity.Budget, 0, len(budgets))
+	for _, b := range budgets {
+		if !b.AppliesTo(month) {
+			continue
+		}
+		if b.Month() == nil && specific[b.CategoryID()+"|"+string(b.ExpenseType())] {
+			continue
+		}
+		applicable = append(applicable, b)
+	}
+
+	return applicable
+}
+
+// budgetStatus projects month-end spending from the pace so far. Until a
+// day of the month has elapsed there is no pace, so the projection is what
+// has been spent.
+func budgetStatus(budget entity.Budget, spent int64, elapsed, daysInMonth int) dto.BudgetStatusDTO {
+	limit := budget.Amount()
+
+	projected := spent
+	if elapsed > 0 {
+		projected = int64(math.Round(float64(spent) * float64(daysInMonth) / float64(elapsed)))
+	}
+
+	return dto.BudgetStatusDTO{
+		BudgetID:            budget.ID(),
+		CategoryID:          budget.CategoryID(),
+		ExpenseType:         string(budget.ExpenseType()),
+		Currency:            budget.Currency(),
+		Limit:               money.Amount(limit),
+		Spent:               money.Amount(spent),
+		Remaining:           money.Amount(limit - spent),
+		PercentUsed:         math.Round(float64(spent)*1000/float64(limit)) / 10,
+		Projected:           money.Amount(projected),
+		OverBudget:          spent > limit,
+		ProjectedOverBudget: projected > limit,
+	}
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBudgetRepository implements data.BudgetRepository in memory for
+// testing
+type MockBudgetRepository struct {
+	data.BudgetRepository
+
+	budgets []entity.Budget
+}
+
+func (m *MockBudgetRepository) FindAll(userID int64) ([]entity.Budget, error) {
+	return m.budgets, nil
+}
+
+// MockReportRepository returns a fixed total per category or expense type
+type MockReportRepository struct {
+	data.ReportRepository
+
+	spent   map[string]int64
+	filters []data.SpendingFilter
+}
+
+func (m *MockReportRepository) SumExpenses(filter data.SpendingFilter) (int64, error) {
+	m.filters = append(m.filters, filter)
+	return m.spent[filter.CategoryID+string(filter.ExpenseType)], nil
+}
+
+func TestGetBudgetStatus(t *testing.T) {
+	now = func() time.Time { return time.Date(2026, 4, 10, 15, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	april := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	food, err := entity.NewBudget(1, nil, "food", "", 30000, "BRL")
+	require.NoError(t, err)
+	foodInApril, err := entity.NewBudget(1, &april, "food", "", 60000, "BRL")
+	require.NoError(t, err)
+	fixed, err := entity.NewBudget(1, nil, "", expenseEntity.FixedExpense, 100000, "BRL")
+	require.NoError(t, err)
+
+	reports := &MockReportRepository{spent: map[string]int64{"food": 25000, string(expenseEntity.FixedExpense): 120000}}
+	store := data.Store{
+		Budgets: &MockBudgetRepository{budgets: []entity.Budget{*food, *foodInApril, *fixed}},
+		Reports: reports,
+	}
+
+	t.Run("current month projects the pace to month end", func(t *testing.T) {
+		reports.filters = nil
+
+		result, err := NewGetBudgetStatusUseCase(store).Execute(1, dto.BudgetStatusQueryDTO{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "2026-04", result.Month)
+		assert.Equal(t, 10, result.DaysElapsed)
+		assert.Equal(t, 30, result.DaysInMonth)
+		require.Len(t, result.Items, 2, "The April budget should replace the every-month one")
+
+		assert.Equal(t, dto.BudgetStatusDTO{
+			BudgetID:            foodInApril.ID(),
+			CategoryID:          "food",
+			Currency:            "BRL",
+			Limit:               money.Amount(60000),
+			Spent:               money.Amount(25000),
+			Remaining:           money.Amount(35000),
+			PercentUsed:         41.7,
+			Projected:           money.Amount(75000),
+			ProjectedOverBudget: true,
+		}, result.Items[0])
+
+		assert.True(t, result.Items[1].OverBudget)
+		assert.Equal(t, money.Amount(-20000), result.Items[1].Remaining)
+
+		require.Len(t, reports.filters, 2)
+		assert.Equal(t, april, reports.filters[0].From)
+		assert.Equal(t, time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC), reports.filters[0].To)
+	})
+
+	t.Run("past month projects what was spent", func(t *testing.T) {
+		result, err := NewGetBudgetStatusUseCase(store).Execute(1, dto.BudgetStatusQueryDTO{Month: "2026-02"})
+		require.NoError(t, err)
+
+		assert.Equal(t, 28, result.DaysElapsed)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, food.ID(), result.Items[0].BudgetID)
+		assert.Equal(t, result.Items[0].Spent, result.Items[0].Projected)
+		assert.Equal(t, 83.3, result.Items[0].PercentUsed)
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		_, err := NewGetBudgetStatusUseCase(store).Execute(1, dto.BudgetStatusQueryDTO{Month: "April"})
+		assert.ErrorIs(t, err, ErrInvalidBudgetQuery)
+	})
+}
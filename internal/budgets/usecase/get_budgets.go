@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+)
+
+type GetBudgetsUseCase struct {
+	store data.Store
+}
+
+func NewGetBudgetsUseCase(store data.Store) *GetBudgetsUseCase {
+	return &GetBudgetsUseCase{store: store}
+}
+
+func (uc *GetBudgetsUseCase) Execute(userID int64) (result []dto.BudgetDTO, err error) {
+	budgets, err := uc.store.Budgets.FindAll(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	result = make([]dto.BudgetDTO, 0, len(budgets))
+	for _, b := range budgets {
+		result = append(result, *b.ToDTO())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+)
+
+type UpdateBudgetUseCase struct {
+	store data.Store
+}
+
+func NewUpdateBudgetUseCase(store data.Store) *UpdateBudgetUseCase {
+	return &UpdateBudgetUseCase{store: store}
+}
+
+// Execute replaces the budget month, scope, amount and currency. An empty
+// currency keeps the current one.
+func (uc *UpdateBudgetUseCase) Execute(userID int64, id string, input dto.BudgetDTO) (result *dto.BudgetDTO, err error) {
+	budget, err := uc.store.Budgets.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find budget by ID: %w", err)
+	}
+
+	month, err := parseOptionalMonth(input.Month)
+	if err != nil {
+		return nil, err
+	}
+	budget.SetMonth(month)
+
+	if err := checkCategory(uc.store, userID, input.CategoryID); err != nil {
+		return nil, err
+	}
+
+	if err := budget.SetScope(input.CategoryID, expenseEntity.ExpenseType(input.ExpenseType)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBudget, err)
+	}
+
+	if err := budget.SetAmount(int64(input.Amount)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBudget, err)
+	}
+
+	if input.Currency != "" {
+		if err := budget.SetCurrency(input.Currency); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBudget, err)
+		}
+	}
+
+	if err := uc.store.Budgets.Update(*budget); err != nil {
+		return nil, fmt.Errorf("failed to save budget: %w", err)
+	}
+
+	return budget.ToDTO(), nil
+}
@@ -0,0 +1,9 @@
+package dto
+
+type CategoryDTO struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	Color    string `json:"color,omitempty"`
+	Icon     string `json:"icon,omitempty"`
+	ParentID string `json:"parent_id,omitempty"`
+}
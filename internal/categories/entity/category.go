@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MarioGN/finance-manager-api/internal/categories/dto"
+	"github.com/google/uuid"
+)
+
+const (
+	maxNameLength = 64
+	maxIconLength = 32
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type Category struct {
+	id       string
+	userID   int64
+	name     string
+	color    string
+	icon     string
+	parentID string
+}
+
+func NewCategory(userID int64, name, color, icon, parentID string) (*Category, error) {
+	if userID <= 0 {
+		return nil, errors.New("category must belong to a user")
+	}
+
+	c := &Category{
+		id:     uuid.New().String(),
+		userID: userID,
+	}
+
+	if err := c.SetName(name); err != nil {
+		return nil, err
+	}
+
+	if err := c.SetColor(color); err != nil {
+		return nil, err
+	}
+
+	if err := c.SetIcon(icon); err != nil {
+		return nil, err
+	}
+
+	if err := c.SetParentID(parentID); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Category) SetName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return errors.New("name must be at most 64 characters long")
+	}
+	c.name = name
+	return nil
+}
+
+// SetColor accepts an empty string or a #RRGGBB hex color.
+func (c *Category) SetColor(color string) error {
+	if color != "" && !colorPattern.MatchString(color) {
+		return errors.New("color must be a #RRGGBB hex value")
+	}
+	c.color = strings.ToLower(color)
+	return nil
+}
+
+func (c *Category) SetIcon(icon string) error {
+	if utf8.RuneCountInString(icon) > maxIconLength {
+		return errors.New("icon must be at most 32 characters long")
+	}
+	c.icon = icon
+	return nil
+}
+
+// SetParentID sets the parent category, or clears it when parentID is empty.
+// Whether the parent exists is checked by the use cases.
+func (c *Category) SetParentID(parentID string) error {
+	if parentID != "" && parentID == c.id {
+		return errors.New("category cannot be its own parent")
+	}
+	c.parentID = parentID
+	return nil
+}
+
+func (c *Category) ToDTO() *dto.CategoryDTO {
+	return &dto.CategoryDTO{
+		ID:       c.id,
+		Name:     c.name,
+		Color:    c.color,
+		Icon:     c.icon,
+		ParentID: c.parentID,
+	}
+}
+
+func (c *Category) ID() string {
+	return c.id
+}
+
+func (c *Category) UserID() int64 {
+	return c.userID
+}
+
+func (c *Category) Name() string {
+	return c.name
+}
+
+func (c *Category) Color() string {
+	return c.color
+}
+
+func (c *Category) Icon() string {
+	return c.icon
+}
+
+func (c *Category) ParentID() string {
+	return c.parentID
+}
+
+func (c *Category) SetID(id string) {
+	c.id = id
+}
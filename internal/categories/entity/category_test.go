@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategory_ValidCreation(t *testing.T) {
+	category, err := NewCategory(1, "  Groceries ", "#A1B2C3", "cart", "")
+	require.NoError(t, err)
+
+	_, err = uuid.Parse(category.ID())
+	assert.NoError(t, err, "Category ID should be a valid UUID")
+
+	assert.Equal(t, int64(1), category.UserID())
+	assert.Equal(t, "Groceries", category.Name(), "Name should be trimmed")
+	assert.Equal(t, "#a1b2c3", category.Color(), "Color should be normalized to lower case")
+	assert.Equal(t, "cart", category.Icon())
+	assert.Empty(t, category.ParentID())
+}
+
+func TestNewCategory_LengthsCountCharacters(t *testing.T) {
+	category, err := NewCategory(1, strings.Repeat("д", 64), "", strings.Repeat("🛒", 32), "")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("д", 64), category.Name())
+
+	_, err = NewCategory(1, strings.Repeat("д", 65), "", "", "")
+	assert.Error(t, err)
+}
+
+func TestNewCategory_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   int64
+		catName  string
+		color    string
+		icon     string
+		parentID string
+	}{
+		{name: "Missing owner", userID: 0, catName: "Rent"},
+		{name: "Empty name", userID: 1, catName: "   "},
+		{name: "Name too long", userID: 1, catName: strings.Repeat("a", 65)},
+		{name: "Color without hash", userID: 1, catName: "Rent", color: "ff0000"},
+		{name: "Short color", userID: 1, catName: "Rent", color: "#fff"},
+		{name: "Icon too long", userID: 1, catName: "Rent", icon: strings.Repeat("i", 33)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category, err := NewCategory(tt.userID, tt.catName, tt.color, tt.icon, tt.parentID)
+			assert.Error(t, err)
+			assert.Nil(t, category)
+		})
+	}
+}
+
+func TestCategory_SetParentID(t *testing.T) {
+	category, err := NewCategory(1, "Transport", "", "", "")
+	require.NoError(t, err)
+
+	assert.Error(t, category.SetParentID(category.ID()), "A category should not be its own parent")
+
+	require.NoError(t, category.SetParentID("parent-id"))
+	assert.Equal(t, "parent-id", category.ParentID())
+
+	require.NoError(t, category.SetParentID(""))
+	assert.Empty(t, category.ParentID())
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/categories/dto"
+	"github.com/MarioGN/finance-manager-api/internal/categories/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var (
+	ErrInvalidCategory = appErrors.Validation("invalid category")
+	ErrInvalidParent   = appErrors.Validation("invalid parent category")
+)
+
+type CreateCategoryUseCase struct {
+	store data.Store
+}
+
+func NewCreateCategoryUseCase(store data.Store) *CreateCategoryUseCase {
+	return &CreateCategoryUseCase{store: store}
+}
+
+func (uc *CreateCategoryUseCase) Execute(userID int64, input dto.CategoryDTO) (result *dto.CategoryDTO, err error) {
+	category, err := entity.NewCategory(userID, input.Name, input.Color, input.Icon, input.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCategory, err)
+	}
+
+	if err := checkParent(uc.store, userID, category.ID(), input.ParentID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.store.Categories.Save(*category); err != nil {
+		return nil, fmt.Errorf("failed to save category: %w", err)
+	}
+
+	return category.ToDTO(), nil
+}
+
+// maxCategoryDepth bounds the walk up the parent chain so that corrupted
+// data cannot loop forever.
+const maxCategoryDepth = 32
+
+// checkParent makes sure that parentID, when set, is one of the user's
+// categories and that making it the parent of categoryID does not create a
+// cycle.
+func checkParent(store data.Store, userID int64, categoryID, parentID string) error {
+	for depth := 0; parentID != ""; depth++ {
+		if parentID == categoryID {
+			return fmt.Errorf("%w: a category cannot be nested under itself", ErrInvalidParent)
+		}
+
+		if depth == maxCategoryDepth {
+			return fmt.Errorf("%w: categories can be nested at most %d levels deep", ErrInvalidParent, maxCategoryDepth)
+		}
+
+		parent, err := store.Categories.FindByID(userID, parentID)
+		if errors.Is(err, data.ErrCategoryNotFound) {
+			return fmt.Errorf("%w: %s does not exist", ErrInvalidParent, parentID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find parent category: %w", err)
+		}
+
+		parentID = parent.ParentID()
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+)
+
+type DeleteCategoryUseCase struct {
+	store data.Store
+}
+
+func NewDeleteCategoryUseCase(store data.Store) *DeleteCategoryUseCase {
+	return &DeleteCategoryUseCase{store: store}
+}
+
+// Execute deletes the category. Its subcategories become top-level
+// categories and its expenses and recurring rules become uncategorized;
+// its budgets are deleted.
+func (uc *DeleteCategoryUseCase) Execute(userID int64, id string) error {
+	if err := uc.store.Categories.Delete(userID, id); err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/categories/dto"
+)
+
+type GetCategoriesUseCase struct {
+	store data.Store
+}
+
+func NewGetCategoriesUseCase(store data.Store) *GetCategoriesUseCase {
+	return &GetCategoriesUseCase{store: store}
+}
+
+func (uc *GetCategoriesUseCase) Execute(userID int64) (result []dto.CategoryDTO, err error) {
+	categories, err := uc.store.Categories.FindAll(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	result = make([]dto.CategoryDTO, 0, len(categories))
+	for _, c := range categories {
+		result = append(result, *c.ToDTO())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/categories/dto"
+)
+
+type GetCategoryUseCase struct {
+	store data.Store
+}
+
+func NewGetCategoryUseCase(store data.Store) *GetCategoryUseCase {
+	return &GetCategoryUseCase{store: store}
+}
+
+func (uc *GetCategoryUseCase) Execute(userID int64, id string) (result *dto.CategoryDTO, err error) {
+	category, err := uc.store.Categories.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category by ID: %w", err)
+	}
+
+	return category.ToDTO(), nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/categories/dto"
+)
+
+type UpdateCategoryUseCase struct {
+	store data.Store
+}
+
+func NewUpdateCategoryUseCase(store data.Store) *UpdateCategoryUseCase {
+	return &UpdateCategoryUseCase{store: store}
+}
+
+func (uc *UpdateCategoryUseCase) Execute(userID int64, id string, input dto.CategoryDTO) (result *dto.CategoryDTO, err error) {
+	category, err := uc.store.Categories.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category by ID: %w", err)
+	}
+
+	if err := category.SetName(input.Name); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCategory, err)
+	}
+
+	if err := category.SetColor(input.Color); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCategory, err)
+	}
+
+	if err := category.SetIcon(input.Icon); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCategory, err)
+	}
+
+	if err := checkParent(uc.store, userID, category.ID(), input.ParentID); err != nil {
+		return nil, err
+	}
+
+	if err := category.SetParentID(input.ParentID); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidParent, err)
+	}
+
+	if err := uc.store.Categories.Update(*category); err != nil {
+		return nil, fmt.Errorf("failed to save category: %w", err)
+	}
+
+	return category.ToDTO(), nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/categories/dto"
+	"github.com/MarioGN/finance-manager-api/internal/categories/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCategoryRepository implements data.CategoryRepository in memory for testing
+type MockCategoryRepository struct {
+	data.CategoryRepository
+
+	categories map[string]entity.Category
+	updated    *entity.Category
+}
+
+func NewMockCategoryRepository(categories ...*entity.Category) *MockCategoryRepository {
+	m := &MockCategoryRepository{categories: map[string]entity.Category{}}
+	for _, c := range categories {
+		m.categories[c.ID()] = *c
+	}
+	return m
+}
+
+func (m *MockCategoryRepository) FindByID(userID int64, id string) (*entity.Category, error) {
+	c, ok := m.categories[id]
+	if !ok || c.UserID() != userID {
+		return nil, fmt.Errorf("%w: %s", data.ErrCategoryNotFound, id)
+	}
+	return &c, nil
+}
+
+func (m *MockCategoryRepository) Update(category entity.Category) error {
+	m.updated = &category
+	return nil
+}
+
+func newCategory(t *testing.T, userID int64, name, parentID string) *entity.Category {
+	t.Helper()
+
+	c, err := entity.NewCategory(userID, name, "", "", parentID)
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestUpdateCategory_Reparent(t *testing.T) {
+	food := newCategory(t, 1, "Food", "")
+	groceries := newCategory(t, 1, "Groceries", food.ID())
+	organic := newCategory(t, 1, "Organic", groceries.ID())
+	transport := newCategory(t, 1, "Transport", "")
+	foreign := newCategory(t, 2, "Not mine", "")
+
+	tests := []struct {
+		name        string
+		id          string
+		parentID    string
+		expectedErr error
+	}{
+		{name: "Move under another root", id: groceries.ID(), parentID: transport.ID()},
+		{name: "Move to the top level", id: organic.ID(), parentID: ""},
+		{name: "Parent is itself", id: food.ID(), parentID: food.ID(), expectedErr: ErrInvalidParent},
+		{name: "Parent is a descendant", id: food.ID(), parentID: organic.ID(), expectedErr: ErrInvalidParent},
+		{name: "Parent belongs to another user", id: food.ID(), parentID: foreign.ID(), expectedErr: ErrInvalidParent},
+		{name: "Parent does not exist", id: food.ID(), parentID: "missing", expectedErr: ErrInvalidParent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockCategoryRepository(food, groceries, organic, transport, foreign)
+			uc := NewUpdateCategoryUseCase(data.Store{Categories: repo})
+
+			current := repo.categories[tt.id]
+			result, err := uc.Execute(1, tt.id, dto.CategoryDTO{Name: current.Name(), ParentID: tt.parentID})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, result)
+				assert.Nil(t, repo.updated, "Invalid updates should not be saved")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.parentID, result.ParentID)
+			require.NotNil(t, repo.updated)
+			assert.Equal(t, tt.parentID, repo.updated.ParentID())
+		})
+	}
+}
+
+func TestUpdateCategory_InvalidFields(t *testing.T) {
+	food := newCategory(t, 1, "Food", "")
+	repo := NewMockCategoryRepository(food)
+	uc := NewUpdateCategoryUseCase(data.Store{Categories: repo})
+
+	_, err := uc.Execute(1, food.ID(), dto.CategoryDTO{Name: "Food", Color: "green"})
+	assert.ErrorIs(t, err, ErrInvalidCategory)
+
+	_, err = uc.Execute(1, "missing", dto.CategoryDTO{Name: "Food"})
+	assert.ErrorIs(t, err, data.ErrCategoryNotFound)
+}
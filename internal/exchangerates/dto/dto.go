@@ -0,0 +1,19 @@
+package dto
+
+type ExchangeRateDTO struct {
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+	Date  string `json:"date"`
+	Rate  string `json:"rate"`
+}
+
+type ExchangeRateQueryDTO struct {
+	Base  string `query:"base"`
+	Quote string `query:"quote"`
+	From  string `query:"from"`
+	To    string `query:"to"`
+}
+
+type ImportResultDTO struct {
+	Imported int `json:"imported"`
+}
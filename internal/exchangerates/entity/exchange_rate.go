@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/dto"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+// ExchangeRate says how many units of the quote currency one unit of the
+// base currency bought on a given date.
+type ExchangeRate struct {
+	userID int64
+	base   string
+	quote  string
+	date   time.Time
+	rate   money.Rate
+}
+
+func NewExchangeRate(userID int64, base, quote string, date time.Time, rate money.Rate) (*ExchangeRate, error) {
+	if userID <= 0 {
+		return nil, errors.New("exchange rate must belong to a user")
+	}
+
+	base, err := money.ParseCurrency(base)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err = money.ParseCurrency(quote)
+	if err != nil {
+		return nil, err
+	}
+
+	if base == quote {
+		return nil, errors.New("base and quote currencies must differ")
+	}
+
+	if date.IsZero() {
+		return nil, errors.New("date must be a valid date")
+	}
+
+	if rate.IsZero() {
+		return nil, errors.New("rate is required")
+	}
+
+	return &ExchangeRate{
+		userID: userID,
+		base:   base,
+		quote:  quote,
+		date:   date,
+		rate:   rate,
+	}, nil
+}
+
+func (r *ExchangeRate) ToDTO() *dto.ExchangeRateDTO {
+	return &dto.ExchangeRateDTO{
+		Base:  r.base,
+		Quote: r.quote,
+		Date:  r.date.Format("2006-01-02"),
+		Rate:  r.rate.String(),
+	}
+}
+
+func (r *ExchangeRate) UserID() int64 {
+	return r.userID
+}
+
+func (r *ExchangeRate) Base() string {
+	return r.base
+}
+
+func (r *ExchangeRate) Quote() string {
+	return r.quote
+}
+
+func (r *ExchangeRate) Date() time.Time {
+	return r.date
+}
+
+func (r *ExchangeRate) Rate() money.Rate {
+	return r.rate
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+type rateKey struct {
+	from string
+	date time.Time
+}
+
+// Converter converts amounts into a single target currency with the user's
+// stored rates. A pair is looked up directly, then inverted, then through a
+// currency both sides have a rate against. The latest rate on or before the
+// amount's date is used.
+type Converter struct {
+	rates  data.ExchangeRateRepository
+	userID int64
+	target string
+	bases  []string
+	cache  map[rateKey]money.Rate
+}
+
+func NewConverter(store data.Store, userID int64, target string) *Converter {
+	return &Converter{
+		rates:  store.Rates,
+		userID: userID,
+		target: target,
+		cache:  make(map[rateKey]money.Rate),
+	}
+}
+
+func (c *Converter) Convert(amount int64, currency string, date time.Time) (int64, error) {
+	if currency == c.target {
+		return amount, nil
+	}
+
+	key := rateKey{from: currency, date: date}
+
+	rate, ok := c.cache[key]
+	if !ok {
+		var err error
+		if rate, err = c.resolve(currency, date); err != nil {
+			return 0, err
+		}
+		c.cache[key] = rate
+	}
+
+	return int64(rate.Convert(money.Amount(amount))), nil
+}
+
+func (c *Converter) resolve(from string, date time.Time) (money.Rate, error) {
+	rate, err := c.lookup(from, c.target, date)
+	if !errors.Is(err, data.ErrExchangeRateNotFound) {
+		return rate, err
+	}
+
+	if c.bases == nil {
+		if c.bases, err = c.rates.Bases(c.userID); err != nil {
+			return money.Rate{}, err
+		}
+	}
+
+	for _, pivot := range c.bases {
+		if pivot == from || pivot == c.target {
+			continue
+		}
+
+		toFrom, err := c.lookup(pivot, from, date)
+		if errors.Is(err, data.ErrExchangeRateNotFound) {
+			continue
+		}
+		if err != nil {
+			return money.Rate{}, err
+		}
+
+		toTarget, err := c.lookup(pivot, c.target, date)
+		if errors.Is(err, data.ErrExchangeRateNotFound) {
+			continue
+		}
+		if err != nil {
+			return money.Rate{}, err
+		}
+
+		return toFrom.Invert().Mul(toTarget), nil
+	}
+
+	return money.Rate{}, fmt.Errorf("%w: no rate from %s to %s on or before %s",
+		data.ErrExchangeRateNotFound, from, c.target, date.Format("2006-01-02"))
+}
+
+// lookup finds the rate converting base into quote, inverting the opposite
+// pair when only that one is stored.
+func (c *Converter) lookup(base, quote string, date time.Time) (money.Rate, error) {
+	rate, err := c.rates.Latest(c.userID, base, quote, date)
+	if err == nil {
+		return rate.Rate(), nil
+	}
+	if !errors.Is(err, data.ErrExchangeRateNotFound) {
+		return money.Rate{}, err
+	}
+
+	rate, err = c.rates.Latest(c.userID, quote, base, date)
+	if err != nil {
+		return money.Rate{}, err
+	}
+
+	return rate.Rate().Invert(), nil
+}
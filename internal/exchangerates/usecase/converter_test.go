@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter(t *testing.T) {
+	rates := &MockExchangeRateRepository{}
+	store := data.Store{Rates: rates}
+
+	_, err := NewImportExchangeRatesUseCase(store).Execute(1, ImportCSV, strings.NewReader(
+		"date,base,quote,rate\n"+
+			"2026-01-01,EUR,USD,1.25\n"+
+			"2026-02-01,EUR,USD,1.10\n"+
+			"2026-01-01,EUR,GBP,0.80\n",
+	))
+	require.NoError(t, err)
+
+	jan := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		target   string
+		amount   int64
+		currency string
+		date     time.Time
+		expected int64
+	}{
+		{name: "Same currency", target: "EUR", amount: 1234, currency: "EUR", date: jan, expected: 1234},
+		{name: "Direct rate", target: "USD", amount: 1000, currency: "EUR", date: jan, expected: 1250},
+		{name: "Latest rate before the date", target: "USD", amount: 1000, currency: "EUR", date: feb, expected: 1100},
+		{name: "Inverse rate", target: "EUR", amount: 1000, currency: "USD", date: jan, expected: 800},
+		{name: "Through a common base", target: "GBP", amount: 1000, currency: "USD", date: jan, expected: 640},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted, err := NewConverter(store, 1, tt.target).Convert(tt.amount, tt.currency, tt.date)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, converted)
+		})
+	}
+
+	t.Run("Missing rate", func(t *testing.T) {
+		_, err := NewConverter(store, 1, "EUR").Convert(1000, "JPY", jan)
+		assert.ErrorIs(t, err, data.ErrExchangeRateNotFound)
+
+		_, err = NewConverter(store, 1, "USD").Convert(1000, "EUR", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+		assert.ErrorIs(t, err, data.ErrExchangeRateNotFound, "Rates from after the date must not be used")
+	})
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/dto"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidExchangeRateQuery = appErrors.Validation("invalid exchange rate query")
+
+type GetExchangeRatesUseCase struct {
+	store data.Store
+}
+
+func NewGetExchangeRatesUseCase(store data.Store) *GetExchangeRatesUseCase {
+	return &GetExchangeRatesUseCase{store: store}
+}
+
+func (uc *GetExchangeRatesUseCase) Execute(userID int64, query dto.ExchangeRateQueryDTO) (result []dto.ExchangeRateDTO, err error) {
+	filter := data.ExchangeRateFilter{
+		UserID: userID,
+		Base:   strings.ToUpper(strings.TrimSpace(query.Base)),
+		Quote:  strings.ToUpper(strings.TrimSpace(query.Quote)),
+	}
+
+	if filter.From, err = parseOptionalDate(query.From); err != nil {
+		return nil, fmt.Errorf("%w: from must be a YYYY-MM-DD date", ErrInvalidExchangeRateQuery)
+	}
+
+	if filter.To, err = parseOptionalDate(query.To); err != nil {
+		return nil, fmt.Errorf("%w: to must be a YYYY-MM-DD date", ErrInvalidExchangeRateQuery)
+	}
+
+	rates, err := uc.store.Rates.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+
+	result = make([]dto.ExchangeRateDTO, 0, len(rates))
+	for _, r := range rates {
+		result = append(result, *r.ToDTO())
+	}
+
+	return result, nil
+}
+
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/dto"
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+type ImportFormat string
+
+const (
+	// ImportCSV expects a header row followed by date,base,quote,rate rows.
+	ImportCSV ImportFormat = "csv"
+	// ImportECB expects the European Central Bank euro reference rate XML,
+	// as published at eurofxref-daily.xml or eurofxref-hist.xml.
+	ImportECB ImportFormat = "ecb"
+)
+
+var ErrInvalidImport = appErrors.Validation("invalid exchange rate import")
+
+type ImportExchangeRatesUseCase struct {
+	store data.Store
+}
+
+func NewImportExchangeRatesUseCase(store data.Store) *ImportExchangeRatesUseCase {
+	return &ImportExchangeRatesUseCase{store: store}
+}
+
+// Execute parses a rate file and stores every rate in it, replacing rates
+// already stored for the same pair and date. Nothing is saved when any
+// entry is invalid.
+func (uc *ImportExchangeRatesUseCase) Execute(userID int64, format ImportFormat, r io.Reader) (*dto.ImportResultDTO, error) {
+	var (
+		rates []entity.ExchangeRate
+		err   error
+	)
+
+	switch format {
+	case ImportCSV:
+		rates, err = parseRatesCSV(userID, r)
+	case ImportECB:
+		rates, err = parseRatesECB(userID, r)
+	default:
+		return nil, fmt.Errorf("%w: format must be csv or ecb", ErrInvalidImport)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	return saveExchangeRates(uc.store, rates)
+}
+
+var csvRateHeader = []string{"date", "base", "quote", "rate"}
+
+func parseRatesCSV(userID int64, r io.Reader) ([]entity.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvRateHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, column := range csvRateHeader {
+		if !strings.EqualFold(strings.TrimSpace(header[i]), column) {
+			return nil, fmt.Errorf("header must be %s", strings.Join(csvRateHeader, ","))
+		}
+	}
+
+	rates := make([]entity.ExchangeRate, 0)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		rate, err := newExchangeRate(userID, record[1], record[2], strings.TrimSpace(record[0]), record[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rates = append(rates, *rate)
+	}
+
+	return rates, nil
+}
+
+// ecbEnvelope maps the nested Cube elements of the ECB reference rate files:
+// one Cube per day, holding one Cube per currency quoted against the euro.
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+func parseRatesECB(userID int64, r io.Reader) ([]entity.ExchangeRate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("malformed XML: %w", err)
+	}
+
+	rates := make([]entity.ExchangeRate, 0)
+
+	for _, day := range envelope.Days {
+		for _, quoted := range day.Rates {
+			rate, err := newExchangeRate(userID, "EUR", quoted.Currency, day.Time, quoted.Rate)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", day.Time, quoted.Currency, err)
+			}
+			rates = append(rates, *rate)
+		}
+	}
+
+	return rates, nil
+}
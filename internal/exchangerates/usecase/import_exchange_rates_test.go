@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockExchangeRateRepository implements data.ExchangeRateRepository in
+// memory for testing
+type MockExchangeRateRepository struct {
+	data.ExchangeRateRepository
+
+	rates []entity.ExchangeRate
+}
+
+func (m *MockExchangeRateRepository) Save(rates []entity.ExchangeRate) error {
+	m.rates = append(m.rates, rates...)
+	return nil
+}
+
+func (m *MockExchangeRateRepository) Latest(userID int64, base, quote string, date time.Time) (*entity.ExchangeRate, error) {
+	var latest *entity.ExchangeRate
+	for i, r := range m.rates {
+		if r.UserID() != userID || r.Base() != base || r.Quote() != quote || r.Date().After(date) {
+			continue
+		}
+		if latest == nil || r.Date().After(latest.Date()) {
+			latest = &m.rates[i]
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("%w: %s/%s", data.ErrExchangeRateNotFound, base, quote)
+	}
+	return latest, nil
+}
+
+func (m *MockExchangeRateRepository) Bases(userID int64) ([]string, error) {
+	seen := map[string]bool{}
+	bases := make([]string, 0)
+	for _, r := range m.rates {
+		if r.UserID() == userID && !seen[r.Base()] {
+			seen[r.Base()] = true
+			bases = append(bases, r.Base())
+		}
+	}
+	return bases, nil
+}
+
+const ecbSample = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2026-01-06">
+			<Cube currency="USD" rate="1.0842"/>
+			<Cube currency="JPY" rate="162.85"/>
+		</Cube>
+		<Cube time="2026-01-05">
+			<Cube currency="USD" rate="1.0810"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestImportExchangeRates(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   ImportFormat
+		body     string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "CSV",
+			format:   ImportCSV,
+			body:     "date,base,quote,rate\n2026-01-05,usd,EUR,0.92\n2026-01-06, GBP, EUR, 1.17\n",
+			expected: []string{"2026-01-05 USD/EUR 0.92", "2026-01-06 GBP/EUR 1.17"},
+		},
+		{
+			name:     "ECB XML",
+			format:   ImportECB,
+			body:     ecbSample,
+			expected: []string{"2026-01-06 EUR/USD 1.0842", "2026-01-06 EUR/JPY 162.85", "2026-01-05 EUR/USD 1.081"},
+		},
+		{name: "CSV without header", format: ImportCSV, body: "2026-01-05,USD,EUR,0.92\n", wantErr: true},
+		{name: "CSV with a bad rate", format: ImportCSV, body: "date,base,quote,rate\n2026-01-05,USD,EUR,-1\n", wantErr: true},
+		{name: "CSV with a bad currency", format: ImportCSV, body: "date,base,quote,rate\n2026-01-05,DOLLAR,EUR,1\n", wantErr: true},
+		{name: "Empty CSV", format: ImportCSV, body: "", wantErr: true},
+		{name: "Malformed XML", format: ImportECB, body: "<Cube>", wantErr: true},
+		{name: "Unknown format", format: "ofx", body: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rates := &MockExchangeRateRepository{}
+			uc := NewImportExchangeRatesUseCase(data.Store{Rates: rates})
+
+			result, err := uc.Execute(1, tt.format, strings.NewReader(tt.body))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidImport)
+				assert.Empty(t, rates.rates, "Nothing should be saved from an invalid file")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.expected), result.Imported)
+
+			saved := make([]string, 0, len(rates.rates))
+			for _, r := range rates.rates {
+				saved = append(saved, fmt.Sprintf("%s %s/%s %s", r.Date().Format("2006-01-02"), r.Base(), r.Quote(), r.Rate()))
+			}
+			assert.Equal(t, tt.expected, saved)
+		})
+	}
+}
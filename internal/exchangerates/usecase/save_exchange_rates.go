@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/dto"
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+var ErrInvalidExchangeRate = appErrors.Validation("invalid exchange rate")
+
+type SaveExchangeRatesUseCase struct {
+	store data.Store
+}
+
+func NewSaveExchangeRatesUseCase(store data.Store) *SaveExchangeRatesUseCase {
+	return &SaveExchangeRatesUseCase{store: store}
+}
+
+// Execute stores manually entered rates. Either all of them are saved or,
+// when one is invalid, none.
+func (uc *SaveExchangeRatesUseCase) Execute(userID int64, input []dto.ExchangeRateDTO) (*dto.ImportResultDTO, error) {
+	rates := make([]entity.ExchangeRate, 0, len(input))
+
+	for i, in := range input {
+		rate, err := newExchangeRate(userID, in.Base, in.Quote, in.Date, in.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %d: %w", ErrInvalidExchangeRate, i, err)
+		}
+		rates = append(rates, *rate)
+	}
+
+	return saveExchangeRates(uc.store, rates)
+}
+
+func newExchangeRate(userID int64, base, quote, date, value string) (*entity.ExchangeRate, error) {
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, errors.New("date must be a YYYY-MM-DD date")
+	}
+
+	rate, err := money.ParseRate(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewExchangeRate(userID, base, quote, parsed, rate)
+}
+
+func saveExchangeRates(store data.Store, rates []entity.ExchangeRate) (*dto.ImportResultDTO, error) {
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w: no rates given", ErrInvalidExchangeRate)
+	}
+
+	if err := store.Rates.Save(rates); err != nil {
+		return nil, fmt.Errorf("failed to save exchange rates: %w", err)
+	}
+
+	return &dto.ImportResultDTO{Imported: len(rates)}, nil
+}
@@ -1,9 +1,80 @@
 package dto
 
+import (
+	"encoding/json"
+
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
 type ExpenseDTO struct {
-	ID          string  `json:"id,omitempty"`
-	Amount      float64 `json:"amount"`
-	Description string  `json:"description"`
-	Date        string  `json:"date"`
-	ExpenseType string  `json:"expense_type"`
+	ID          string       `json:"id,omitempty"`
+	Amount      money.Amount `json:"amount"`
+	Currency    string       `json:"currency"`
+	Description string       `json:"description"`
+	Date        string       `json:"date"`
+	ExpenseType string       `json:"expense_type"`
+	CategoryID  string       `json:"category_id,omitempty"`
+	AccountID   string       `json:"account_id,omitempty"`
+	ExternalID  string       `json:"external_id,omitempty"`
+	Tags        []string     `json:"tags"`
+	// DeletedAt is set on expenses in the trash only.
+	DeletedAt string `json:"deleted_at,omitempty"`
+	// Version is sent in the ETag header rather than in the body.
+	Version int64 `json:"-"`
+	// AmountErr is why the amount of a request could not be read. It is
+	// reported with the other violations of the expense rather than on its
+	// own.
+	AmountErr error `json:"-"`
+}
+
+// UnmarshalJSON reads an expense, keeping an amount that cannot be read in
+// AmountErr instead of failing.
+func (e *ExpenseDTO) UnmarshalJSON(data []byte) error {
+	type fields ExpenseDTO
+	input := struct {
+		*fields
+		Amount json.RawMessage `json:"amount"`
+	}{fields: (*fields)(e)}
+
+	err := json.Unmarshal(data, &input)
+	if input.Amount != nil {
+		e.AmountErr = e.Amount.UnmarshalJSON(input.Amount)
+	}
+
+	return err
+}
+
+type ExpenseQueryDTO struct {
+	From        string   `query:"from"`
+	To          string   `query:"to"`
+	ExpenseType string   `query:"expense_type"`
+	CategoryID  string   `query:"category_id"`
+	AccountID   string   `query:"account_id"`
+	Tag         []string `query:"tag"`
+	TagsAll     []string `query:"tags_all"`
+	AmountMin   string   `query:"amount_min"`
+	AmountMax   string   `query:"amount_max"`
+	Description string   `query:"description"`
+	Sort        string   `query:"sort"`
+	Order       string   `query:"order"`
+	Limit       int      `query:"limit"`
+	Offset      int      `query:"offset"`
+}
+
+type ExpenseListDTO struct {
+	Items  []ExpenseDTO `json:"items"`
+	Total  int64        `json:"total"`
+	Limit  int          `json:"limit"`
+	Offset int          `json:"offset"`
+}
+
+// ExportOptionsDTO picks the file format of an export and, for CSV and XLSX,
+// how amounts and dates are written. A locale such as de-DE sets both; the
+// other options override it.
+type ExportOptionsDTO struct {
+	Format           string `query:"format"`
+	Locale           string `query:"locale"`
+	DecimalSeparator string `query:"decimal_separator"`
+	DateFormat       string `query:"date_format"`
+	Delimiter        string `query:"delimiter"`
 }
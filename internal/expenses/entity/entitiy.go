@@ -2,12 +2,23 @@ package entity
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	dto "github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	tagEntity "github.com/MarioGN/finance-manager-api/internal/tags/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
 	"github.com/google/uuid"
 )
 
+var (
+	ErrInvalidAmount      = errors.New("amount must be greater than zero")
+	ErrInvalidDate        = errors.New("date must be a valid date")
+	ErrInvalidExpenseType = errors.New("invalid expense type")
+)
+
 type ExpenseType string
 
 func (e ExpenseType) IsValid() bool {
@@ -21,51 +32,78 @@ func (e ExpenseType) IsValid() bool {
 
 type Expense struct {
 	id          string
+	userID      int64
 	amount      int64
+	currency    string
 	description string
 	date        time.Time
 	expenseType ExpenseType
+	categoryID  string
+	accountID   string
+	externalID  string
+	tags        []string
+	version     int64
+	deletedAt   time.Time
 }
 
-func NewExpense(amount int64, description string, date time.Time, expeseType ExpenseType) (*Expense, error) {
+const maxTagsPerExpense = 20
+
+func NewExpense(userID int64, amount int64, description string, date time.Time, expeseType ExpenseType) (*Expense, error) {
 	uuid := uuid.New().String()
 
+	if userID <= 0 {
+		return nil, errors.New("expense must belong to a user")
+	}
+
 	if amount <= 0 {
-		return nil, errors.New("amount must be greater than zero")
+		return nil, ErrInvalidAmount
 	}
 
 	if date.IsZero() {
-		return nil, errors.New("date must be a valid date")
+		return nil, ErrInvalidDate
 	}
 
 	if !expeseType.IsValid() {
-		return nil, errors.New("invalid expense type")
+		return nil, ErrInvalidExpenseType
 	}
 
 	return &Expense{
 		id:          uuid,
+		userID:      userID,
 		amount:      amount,
+		currency:    money.DefaultCurrency,
 		description: description,
 		date:        date,
 		expenseType: expeseType,
+		version:     1,
 	}, nil
 }
 
 func (e *Expense) SetAmount(amount int64) error {
 	if amount <= 0 {
-		return errors.New("amount must be greater than zero")
+		return ErrInvalidAmount
 	}
 	e.amount = amount
 	return nil
 }
 
+// SetCurrency sets the ISO 4217 currency of the amount.
+func (e *Expense) SetCurrency(currency string) error {
+	code, err := money.ParseCurrency(currency)
+	if err != nil {
+		return err
+	}
+	e.currency = code
+	return nil
+}
+
 func (e *Expense) SetDescription(description string) {
 	e.description = description
 }
 
 func (e *Expense) SetDate(date time.Time) error {
 	if date.IsZero() {
-		return errors.New("date must be a valid date")
+		return ErrInvalidDate
 	}
 	e.date = date
 	return nil
@@ -73,32 +111,104 @@ func (e *Expense) SetDate(date time.Time) error {
 
 func (e *Expense) SetExpenseType(expenseType ExpenseType) error {
 	if !expenseType.IsValid() {
-		return errors.New("invalid expense type")
+		return ErrInvalidExpenseType
 	}
 	e.expenseType = expenseType
 	return nil
 }
 
-func (e *Expense) ToDTO() *dto.ExpenseDTO {
-	floatAmount := float64(e.amount) / 100.0
+// SetCategoryID assigns the expense to a category, or clears it when
+// categoryID is empty. Whether the category exists is checked by the use
+// cases.
+func (e *Expense) SetCategoryID(categoryID string) {
+	e.categoryID = categoryID
+}
+
+// SetAccountID records which account the expense was paid from, or clears
+// it when accountID is empty. Whether the account exists is checked by the
+// use cases.
+func (e *Expense) SetAccountID(accountID string) {
+	e.accountID = accountID
+}
+
+// SetExternalID records the bank's reference for the transaction the
+// expense was imported from.
+func (e *Expense) SetExternalID(externalID string) {
+	e.externalID = externalID
+}
+
+// SetTags replaces the expense tags. Names are trimmed, de-duplicated
+// case-insensitively and kept in alphabetical order.
+func (e *Expense) SetTags(tags []string) error {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		name, err := tagEntity.NormalizeName(tag)
+		if err != nil {
+			return fmt.Errorf("invalid tag %q: %w", tag, err)
+		}
+
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, name)
+	}
+
+	if len(normalized) > maxTagsPerExpense {
+		return fmt.Errorf("an expense can have at most %d tags", maxTagsPerExpense)
+	}
+
+	sort.Slice(normalized, func(i, j int) bool {
+		return strings.ToLower(normalized[i]) < strings.ToLower(normalized[j])
+	})
+
+	e.tags = normalized
+	return nil
+}
 
+func (e *Expense) ToDTO() *dto.ExpenseDTO {
 	return &dto.ExpenseDTO{
 		ID:          e.id,
-		Amount:      floatAmount,
+		Amount:      money.Amount(e.amount),
+		Currency:    e.currency,
 		Description: e.description,
 		Date:        e.date.Format("2006-01-02"),
 		ExpenseType: string(e.expenseType),
+		CategoryID:  e.categoryID,
+		AccountID:   e.accountID,
+		ExternalID:  e.externalID,
+		Tags:        e.Tags(),
+		Version:     e.version,
+		DeletedAt:   formatDeletedAt(e.deletedAt),
+	}
+}
+
+func formatDeletedAt(deletedAt time.Time) string {
+	if deletedAt.IsZero() {
+		return ""
 	}
+	return deletedAt.UTC().Format(time.RFC3339)
 }
 
 func (e *Expense) ID() string {
 	return e.id
 }
 
+func (e *Expense) UserID() int64 {
+	return e.userID
+}
+
 func (e *Expense) Amount() int64 {
 	return e.amount
 }
 
+func (e *Expense) Currency() string {
+	return e.currency
+}
+
 func (e *Expense) Description() string {
 	return e.description
 }
@@ -111,6 +221,46 @@ func (e *Expense) ExpenseType() ExpenseType {
 	return e.expenseType
 }
 
+func (e *Expense) CategoryID() string {
+	return e.categoryID
+}
+
+func (e *Expense) AccountID() string {
+	return e.accountID
+}
+
+func (e *Expense) ExternalID() string {
+	return e.externalID
+}
+
+func (e *Expense) Tags() []string {
+	tags := make([]string, len(e.tags))
+	copy(tags, e.tags)
+	return tags
+}
+
+// Version counts the saved changes of the expense, starting at 1.
+func (e *Expense) Version() int64 {
+	return e.version
+}
+
 func (e *Expense) SetID(id string) {
 	e.id = id
 }
+
+// DeletedAt is when the expense was moved to the trash, or the zero time
+// while it is not in the trash.
+func (e *Expense) DeletedAt() time.Time {
+	return e.deletedAt
+}
+
+// SetDeletedAt moves the expense to the trash at the given time, or takes it
+// out of the trash for the zero time.
+func (e *Expense) SetDeletedAt(deletedAt time.Time) {
+	e.deletedAt = deletedAt
+}
+
+// SetVersion restores the version the expense was saved with.
+func (e *Expense) SetVersion(version int64) {
+	e.version = version
+}
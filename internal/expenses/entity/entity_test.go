@@ -1,13 +1,21 @@
 package entity
 
 import (
+	stdErrors "errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/validation"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+const testUserID int64 = 1
+
 func TestNewExpense_ExpensesCreation(t *testing.T) {
 	testDate := time.Now()
 
@@ -43,7 +51,7 @@ func TestNewExpense_ExpensesCreation(t *testing.T) {
 		}
 
 		for _, tc := range tests {
-			expense, err := NewExpense(tc.amount, tc.description, tc.date, tc.expenseType)
+			expense, err := NewExpense(testUserID, tc.amount, tc.description, tc.date, tc.expenseType)
 			assert.NoError(t, err, "Expected no error when creating a valid expense")
 
 			assert.NotNil(t, expense, "Expense should not be nil")
@@ -52,6 +60,7 @@ func TestNewExpense_ExpensesCreation(t *testing.T) {
 			_, err = uuid.Parse(expense.id)
 			assert.NoError(t, err, "Expense ID should be a valid UUID")
 
+			assert.Equal(t, testUserID, expense.userID, "Expense owner should match")
 			assert.Equal(t, tc.amount, expense.amount, "Expense amount should match")
 			assert.Equal(t, tc.description, expense.description, "Expense description should match")
 			assert.Equal(t, tc.date, expense.date, "Expense date should match")
@@ -85,7 +94,7 @@ func TestNewExpense_ExpensesCreation(t *testing.T) {
 
 		for _, tc := range tests {
 			t.Run(tc.name, func(t *testing.T) {
-				expense, err := NewExpense(tc.amount, tc.description, tc.date, tc.expenseType)
+				expense, err := NewExpense(testUserID, tc.amount, tc.description, tc.date, tc.expenseType)
 				assert.Error(t, err, "Expected error when creating an expense with zero or negative amount")
 				assert.Nil(t, expense, "Expense should be nil when creation fails")
 			})
@@ -96,7 +105,7 @@ func TestNewExpense_ExpensesCreation(t *testing.T) {
 	t.Run("Should not allow expenses with no date set", func(t *testing.T) {
 		testDate := time.Time{} // Zero value of time.Time
 
-		expense, err := NewExpense(10000, "Electricity bill", testDate, VariableExpense)
+		expense, err := NewExpense(testUserID, 10000, "Electricity bill", testDate, VariableExpense)
 		assert.Error(t, err, "Expected error when creating an expense with no date set")
 		assert.Nil(t, expense, "Expense should be nil when creation fails")
 	})
@@ -134,7 +143,7 @@ func TestNewExpense_ExpensesCreation(t *testing.T) {
 
 		for _, tc := range tests {
 			t.Run(tc.name, func(t *testing.T) {
-				expense, err := NewExpense(tc.amount, tc.description, tc.date, tc.expenseType)
+				expense, err := NewExpense(testUserID, tc.amount, tc.description, tc.date, tc.expenseType)
 				assert.NoError(t, err, "Expected no error when creating an expense with a valid expenseType")
 				assert.NotNil(t, expense, "Expense should not be nil when creation succeeds")
 			})
@@ -144,8 +153,99 @@ func TestNewExpense_ExpensesCreation(t *testing.T) {
 	t.Run("Should return an error when expenseType is not valid", func(t *testing.T) {
 		invalidExpenseType := ExpenseType("invalid_type")
 
-		expense, err := NewExpense(10000, "Electricity bill", testDate, invalidExpenseType)
+		expense, err := NewExpense(testUserID, 10000, "Electricity bill", testDate, invalidExpenseType)
 		assert.Error(t, err, "Expected error when creating an expense with an invalid expenseType")
 		assert.Nil(t, expense, "Expense should be nil when creation fails")
 	})
+
+	t.Run("Should return an error when expense has no owner", func(t *testing.T) {
+		expense, err := NewExpense(0, 10000, "Electricity bill", testDate, VariableExpense)
+		assert.Error(t, err, "Expected error when creating an expense without an owner")
+		assert.Nil(t, expense, "Expense should be nil when creation fails")
+	})
+}
+
+func TestExpense_SetTags(t *testing.T) {
+	expense, err := NewExpense(testUserID, 10000, "Hotel", time.Now(), UnplannedExpense)
+	assert.NoError(t, err)
+
+	t.Run("Normalizes and de-duplicates tags", func(t *testing.T) {
+		err := expense.SetTags([]string{" vacation-2026", "Reimbursable", "reimbursable", "business"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"business", "Reimbursable", "vacation-2026"}, expense.Tags())
+		assert.Equal(t, expense.Tags(), expense.ToDTO().Tags)
+	})
+
+	t.Run("Rejects invalid tags", func(t *testing.T) {
+		err := expense.SetTags([]string{"ok", "not ok"})
+		assert.Error(t, err)
+		assert.Equal(t, []string{"business", "Reimbursable", "vacation-2026"}, expense.Tags(), "Tags should be unchanged on error")
+	})
+
+	t.Run("Rejects too many tags", func(t *testing.T) {
+		tags := make([]string, 0, maxTagsPerExpense+1)
+		for i := 0; i <= maxTagsPerExpense; i++ {
+			tags = append(tags, fmt.Sprintf("tag-%d", i))
+		}
+		assert.Error(t, expense.SetTags(tags))
+	})
+
+	t.Run("Empty list clears tags and serializes as an empty array", func(t *testing.T) {
+		assert.NoError(t, expense.SetTags(nil))
+		assert.Empty(t, expense.Tags())
+		assert.NotNil(t, expense.ToDTO().Tags)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	defer func(original func() time.Time) { now = original }(now)
+	now = func() time.Time { return time.Date(2026, 5, 10, 15, 0, 0, 0, time.UTC) }
+
+	fields := func(amount int64, description string, date time.Time, expenseType ExpenseType) map[string]error {
+		var v validation.Violations
+		Validate(&v, amount, description, date, expenseType)
+
+		found := make(map[string]error)
+		for _, field := range appErrors.Fields(v.Err()) {
+			found[field.Pointer] = stdErrors.New(field.Detail)
+		}
+		return found
+	}
+
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	assert.Empty(t, fields(100, "Groceries", day(2026, 5, 10), VariableExpense))
+	assert.Empty(t, fields(100, strings.Repeat("é", MaxDescriptionLength), day(2027, 5, 11), FixedExpense),
+		"Limits should be inclusive and count characters")
+
+	found := fields(0, strings.Repeat("x", MaxDescriptionLength+1), day(2027, 5, 12), "monthly")
+	assert.Len(t, found, 4)
+	assert.EqualError(t, found["/amount"], ErrInvalidAmount.Error())
+	assert.EqualError(t, found["/description"], ErrDescriptionTooLong.Error())
+	assert.EqualError(t, found["/date"], ErrDateTooFarAhead.Error())
+	assert.Contains(t, found["/expense_type"].Error(), "use fixed, variable or unplanned")
+
+	assert.EqualError(t, fields(100, "", time.Time{}, VariableExpense)["/date"], ErrMissingDate.Error())
+}
+
+func TestValidateTags(t *testing.T) {
+	var v validation.Violations
+	ValidateTags(&v, []string{"food", "", "Food"})
+
+	var pointers []string
+	for _, field := range appErrors.Fields(v.Err()) {
+		pointers = append(pointers, field.Pointer)
+	}
+	assert.Equal(t, []string{"/tags/1"}, pointers)
+
+	tooMany := make([]string, maxTagsPerExpense+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag-%d", i)
+	}
+	v = validation.Violations{}
+	ValidateTags(&v, tooMany)
+	require.Len(t, appErrors.Fields(v.Err()), 1)
+	assert.Equal(t, "/tags", appErrors.Fields(v.Err())[0].Pointer)
 }
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tagEntity "github.com/MarioGN/finance-manager-api/internal/tags/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/validation"
+)
+
+const (
+	// MaxDescriptionLength is the longest description, in characters, a new
+	// or changed expense may have.
+	MaxDescriptionLength = 500
+	// MaxDaysAhead is how far in the future a new or changed expense may be
+	// dated, so that a planned payment can be entered early but a mistyped
+	// year is caught.
+	MaxDaysAhead = 366
+)
+
+var (
+	ErrDescriptionTooLong = fmt.Errorf("description must be at most %d characters long", MaxDescriptionLength)
+	ErrDateTooFarAhead    = fmt.Errorf("date must be at most %d days in the future", MaxDaysAhead)
+	ErrMissingDate        = errors.New("date is required")
+)
+
+var now = time.Now
+
+// Validate adds to v every way in which the values of a new or changed
+// expense break the rules, at the JSON pointers of dto.ExpenseDTO. It checks
+// more than NewExpense, which also rebuilds expenses saved under older
+// rules. A zero date is reported as missing.
+func Validate(v *validation.Violations, amount int64, description string, date time.Time, expenseType ExpenseType) {
+	if amount <= 0 {
+		v.Add("/amount", ErrInvalidAmount)
+	}
+
+	if utf8.RuneCountInString(description) > MaxDescriptionLength {
+		v.Add("/description", ErrDescriptionTooLong)
+	}
+
+	today := now()
+	limit := time.Date(today.Year(), today.Month(), today.Day()+MaxDaysAhead, 0, 0, 0, 0, time.UTC)
+	switch {
+	case date.IsZero():
+		v.Add("/date", ErrMissingDate)
+	case date.After(limit):
+		v.Add("/date", ErrDateTooFarAhead)
+	}
+
+	if !expenseType.IsValid() {
+		v.Add("/expense_type", fmt.Errorf("%w: use %s, %s or %s", ErrInvalidExpenseType, FixedExpense, VariableExpense, UnplannedExpense))
+	}
+}
+
+// ValidateTags adds to v the tags SetTags would reject, each at its index
+// under /tags.
+func ValidateTags(v *validation.Violations, tags []string) {
+	distinct := make(map[string]bool, len(tags))
+
+	for i, tag := range tags {
+		name, err := tagEntity.NormalizeName(tag)
+		if err != nil {
+			v.Add(validation.Pointer("tags", i), fmt.Errorf("invalid tag %q: %w", tag, err))
+			continue
+		}
+		distinct[strings.ToLower(name)] = true
+	}
+
+	if len(distinct) > maxTagsPerExpense {
+		v.Add("/tags", fmt.Errorf("an expense can have at most %d tags", maxTagsPerExpense))
+	}
+}
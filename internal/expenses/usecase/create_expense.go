@@ -1,12 +1,26 @@
 package usecase
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/MarioGN/finance-manager-api/data"
+	accounts "github.com/MarioGN/finance-manager-api/internal/accounts/usecase"
 	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
 	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	notifications "github.com/MarioGN/finance-manager-api/internal/notifications/usecase"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/validation"
+)
+
+var (
+	ErrInvalidExpense  = appErrors.Validation("invalid expense")
+	ErrInvalidCategory = appErrors.Validation("category does not exist")
+	ErrInvalidAccount  = appErrors.Validation("account does not exist")
+	ErrInvalidCurrency = accounts.ErrInvalidCurrency
+	ErrInvalidTags     = appErrors.Validation("invalid tags")
 )
 
 type CreateExpenseUseCase struct {
@@ -17,20 +31,102 @@ func NewCreateExpenseUseCase(store data.Store) *CreateExpenseUseCase {
 	return &CreateExpenseUseCase{store: store}
 }
 
-func (uc *CreateExpenseUseCase) Execute(input dto.ExpenseDTO) (result *dto.ExpenseDTO, err error) {
-	date, err := time.Parse("2006-01-02", input.Date)
+func (uc *CreateExpenseUseCase) Execute(userID int64, input dto.ExpenseDTO) (result *dto.ExpenseDTO, err error) {
+	date, err := validateExpense(input)
 	if err != nil {
-		return nil, fmt.Errorf("invalid date format: %w", err)
+		return nil, err
 	}
 
-	newExpense, err := entity.NewExpense(int64(input.Amount*100), input.Description, date, entity.ExpenseType(input.ExpenseType))
+	newExpense, err := entity.NewExpense(userID, int64(input.Amount), input.Description, date, entity.ExpenseType(input.ExpenseType))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create expense entity: %w", err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpense, err)
+	}
+
+	if err := checkCategory(uc.store, userID, input.CategoryID); err != nil {
+		return nil, err
+	}
+	newExpense.SetCategoryID(input.CategoryID)
+
+	currency, err := accountCurrency(uc.store, userID, input.AccountID, input.Currency, "")
+	if err != nil {
+		return nil, err
+	}
+	newExpense.SetAccountID(input.AccountID)
+	if err := newExpense.SetCurrency(currency); err != nil {
+		return nil, appErrors.Field("/currency", fmt.Errorf("%w: %w", ErrInvalidCurrency, err))
+	}
+
+	if err := newExpense.SetTags(input.Tags); err != nil {
+		return nil, appErrors.Field("/tags", fmt.Errorf("%w: %w", ErrInvalidTags, err))
 	}
 
 	if err := uc.store.Expenses.Save(*newExpense); err != nil {
 		return nil, fmt.Errorf("failed to save expense: %w", err)
 	}
 
+	checkBudgets(uc.store, userID, newExpense.Date())
+
 	return newExpense.ToDTO(), nil
 }
+
+// validateExpense checks every field of an expense request that needs no
+// lookup, reporting all violations at once, and returns the parsed date.
+func validateExpense(input dto.ExpenseDTO) (time.Time, error) {
+	var v validation.Violations
+	v.Check("/amount", input.AmountErr)
+
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil && input.Date != "" {
+		v.Add("/date", fmt.Errorf("%w: use YYYY-MM-DD", entity.ErrInvalidDate))
+	}
+
+	entity.Validate(&v, int64(input.Amount), input.Description, date, entity.ExpenseType(input.ExpenseType))
+	entity.ValidateTags(&v, input.Tags)
+
+	if err := v.Err(); err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidExpense, err)
+	}
+
+	return date, nil
+}
+
+// checkBudgets raises budget alerts for the months of dates. The expense
+// change is already saved by then, so a failure is only logged.
+func checkBudgets(store data.Store, userID int64, dates ...time.Time) {
+	if err := notifications.NewCheckBudgetsUseCase(store).Execute(userID, dates...); err != nil {
+		log.Print("Failed to check budgets: ", err)
+	}
+}
+
+// checkCategory makes sure that a non-empty categoryID refers to one of the
+// user's categories.
+func checkCategory(store data.Store, userID int64, categoryID string) error {
+	if categoryID == "" {
+		return nil
+	}
+
+	_, err := store.Categories.FindByID(userID, categoryID)
+	if errors.Is(err, data.ErrCategoryNotFound) {
+		return appErrors.Field("/category_id", fmt.Errorf("%w: %s", ErrInvalidCategory, categoryID))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find category: %w", err)
+	}
+
+	return nil
+}
+
+// accountCurrency resolves the currency of an expense, see
+// accounts.ResolveCurrency, and reports an unknown account or a currency
+// that does not fit at its JSON pointer.
+func accountCurrency(store data.Store, userID int64, accountID, requested, current string) (string, error) {
+	currency, err := accounts.ResolveCurrency(store, userID, accountID, requested, current)
+	switch {
+	case errors.Is(err, data.ErrAccountNotFound):
+		return "", appErrors.Field("/account_id", fmt.Errorf("%w: %s", ErrInvalidAccount, accountID))
+	case errors.Is(err, ErrInvalidCurrency):
+		return "", appErrors.Field("/currency", err)
+	}
+
+	return currency, err
+}
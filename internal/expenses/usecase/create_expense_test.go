@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	accountEntity "github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	authEntity "github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	budgetEntity "github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	categoryEntity "github.com/MarioGN/finance-manager-api/internal/categories/entity"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCategoryRepository implements data.CategoryRepository for testing
+type MockCategoryRepository struct {
+	data.CategoryRepository
+
+	categories map[string]int64
+}
+
+func (m *MockCategoryRepository) FindByID(userID int64, id string) (*categoryEntity.Category, error) {
+	owner, ok := m.categories[id]
+	if !ok || owner != userID {
+		return nil, fmt.Errorf("%w: %s", data.ErrCategoryNotFound, id)
+	}
+
+	c, err := categoryEntity.NewCategory(owner, "Category", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	c.SetID(id)
+
+	return c, nil
+}
+
+func (m *MockCategoryRepository) FindAll(userID int64) ([]categoryEntity.Category, error) {
+	categories := make([]categoryEntity.Category, 0)
+	for id, owner := range m.categories {
+		if owner == userID {
+			c, err := m.FindByID(userID, id)
+			if err != nil {
+				return nil, err
+			}
+			categories = append(categories, *c)
+		}
+	}
+	return categories, nil
+}
+
+// MockUserRepository implements repository.UserRepository for testing
+type MockUserRepository struct {
+	repository.UserRepository
+
+	baseCurrency string
+}
+
+func (m *MockUserRepository) FindByID(id int64) (*authEntity.UserAccount, error) {
+	user := authEntity.RestoreUserAccount(id, "user@example.com", "")
+	if err := user.SetBaseCurrency(m.baseCurrency); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// MockAccountRepository implements data.AccountRepository for testing
+type MockAccountRepository struct {
+	data.AccountRepository
+
+	accounts map[string]accountEntity.Account
+}
+
+func (m *MockAccountRepository) FindByID(userID int64, id string) (*accountEntity.Account, error) {
+	a, ok := m.accounts[id]
+	if !ok || a.UserID() != userID {
+		return nil, fmt.Errorf("%w: %s", data.ErrAccountNotFound, id)
+	}
+	return &a, nil
+}
+
+func (m *MockAccountRepository) FindAll(userID int64) ([]data.AccountBalance, error) {
+	accounts := make([]data.AccountBalance, 0)
+	for _, a := range m.accounts {
+		if a.UserID() == userID {
+			accounts = append(accounts, data.AccountBalance{Account: a})
+		}
+	}
+	return accounts, nil
+}
+
+// MockBudgetRepository has no budgets, so expense changes raise no alerts
+type MockBudgetRepository struct {
+	data.BudgetRepository
+}
+
+func (m *MockBudgetRepository) FindAll(userID int64) ([]budgetEntity.Budget, error) {
+	return []budgetEntity.Budget{}, nil
+}
+
+// MockSavingExpenseRepository records the saved expense
+type MockSavingExpenseRepository struct {
+	data.ExpenseRepository
+
+	saved *entity.Expense
+}
+
+func (m *MockSavingExpenseRepository) Save(expense entity.Expense) error {
+	m.saved = &expense
+	return nil
+}
+
+func TestCreateExpense_Category(t *testing.T) {
+	categories := &MockCategoryRepository{categories: map[string]int64{"mine": 1, "theirs": 2}}
+
+	tests := []struct {
+		name        string
+		categoryID  string
+		expectedErr error
+	}{
+		{name: "Without category", categoryID: ""},
+		{name: "Own category", categoryID: "mine"},
+		{name: "Another user's category", categoryID: "theirs", expectedErr: ErrInvalidCategory},
+		{name: "Unknown category", categoryID: "missing", expectedErr: ErrInvalidCategory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expenses := &MockSavingExpenseRepository{}
+			uc := NewCreateExpenseUseCase(data.Store{Expenses: expenses, Categories: categories, Users: &MockUserRepository{baseCurrency: "EUR"}, Budgets: &MockBudgetRepository{}})
+
+			result, err := uc.Execute(1, dto.ExpenseDTO{
+				Amount:      1250,
+				Description: "Lunch",
+				Date:        "2026-03-01",
+				ExpenseType: "variable",
+				CategoryID:  tt.categoryID,
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, expenses.saved, "Expense should not be saved")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.categoryID, result.CategoryID)
+			require.NotNil(t, expenses.saved)
+			assert.Equal(t, tt.categoryID, expenses.saved.CategoryID())
+		})
+	}
+}
+
+func TestCreateExpense_Currency(t *testing.T) {
+	dollars, err := accountEntity.NewAccount(1, "Dollars", accountEntity.CheckingAccount, "USD", 0)
+	require.NoError(t, err)
+
+	store := data.Store{
+		Users:    &MockUserRepository{baseCurrency: "CHF"},
+		Accounts: &MockAccountRepository{accounts: map[string]accountEntity.Account{dollars.ID(): *dollars}},
+		Budgets:  &MockBudgetRepository{},
+	}
+
+	tests := []struct {
+		name        string
+		currency    string
+		accountID   string
+		expected    string
+		expectedErr error
+	}{
+		{name: "Defaults to the base currency", expected: "CHF"},
+		{name: "Explicit currency", currency: "gbp", expected: "GBP"},
+		{name: "Taken from the account", accountID: dollars.ID(), expected: "USD"},
+		{name: "Matching the account", currency: "USD", accountID: dollars.ID(), expected: "USD"},
+		{name: "Differing from the account", currency: "EUR", accountID: dollars.ID(), expectedErr: ErrInvalidCurrency},
+		{name: "Not a currency code", currency: "euro", expectedErr: ErrInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expenses := &MockSavingExpenseRepository{}
+			store.Expenses = expenses
+
+			result, err := NewCreateExpenseUseCase(store).Execute(1, dto.ExpenseDTO{
+				Amount:      1250,
+				Currency:    tt.currency,
+				Description: "Lunch",
+				Date:        "2026-03-01",
+				ExpenseType: "variable",
+				AccountID:   tt.accountID,
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, expenses.saved, "Expense should not be saved")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Currency)
+			require.NotNil(t, expenses.saved)
+			assert.Equal(t, tt.expected, expenses.saved.Currency())
+		})
+	}
+}
@@ -2,10 +2,13 @@ package usecase
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/MarioGN/finance-manager-api/data"
 )
 
+var now = time.Now
+
 type DeleteExpenseUseCase struct {
 	store data.Store
 }
@@ -14,19 +17,29 @@ func NewDeleteExpenseUseCase(store data.Store) *DeleteExpenseUseCase {
 	return &DeleteExpenseUseCase{store: store}
 }
 
-func (uc *DeleteExpenseUseCase) Execute(id string) error {
-	dbExpense, err := uc.store.Expenses.FindByID(id)
+// Execute moves the expense to the trash if it still has the given version,
+// from where it can be restored until it is purged. Version 0 matches any
+// version.
+func (uc *DeleteExpenseUseCase) Execute(userID int64, id string, version int64) error {
+	dbExpense, err := uc.store.Expenses.FindByID(userID, id)
 	if err != nil {
 		return fmt.Errorf("failed to find expense by ID: %w", err)
 	}
 
 	if dbExpense == nil {
-		return fmt.Errorf("expense not found")
+		return data.ErrExpenseNotFound
+	}
+
+	if err := checkVersion(dbExpense, version); err != nil {
+		return err
 	}
 
-	if err := uc.store.Expenses.Delete(id); err != nil {
+	dbExpense.SetDeletedAt(now())
+	if err := uc.store.Expenses.Trash(*dbExpense); err != nil {
 		return fmt.Errorf("failed to delete expense: %w", err)
 	}
 
+	checkBudgets(uc.store, userID, dbExpense.Date())
+
 	return nil
 }
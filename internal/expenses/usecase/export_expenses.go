@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/locale"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/MarioGN/finance-manager-api/pkg/xlsx"
+)
+
+type ExportFormat string
+
+const (
+	// ExportCSV writes one line per expense, with the amounts and dates of
+	// the chosen locale.
+	ExportCSV ExportFormat = "csv"
+	// ExportJSONL writes one expense per line as in the API, whatever the
+	// locale.
+	ExportJSONL ExportFormat = "jsonl"
+	// ExportXLSX writes an Excel workbook with amounts and dates as numbers,
+	// dates shown in the format of the chosen locale.
+	ExportXLSX ExportFormat = "xlsx"
+)
+
+var ErrInvalidExport = appErrors.Validation("invalid export")
+
+var exportHeader = []string{"Date", "Description", "Amount", "Currency", "Type", "Category", "Account", "Tags", "ID"}
+
+// Export is a checked export request. Nothing is read until it is written.
+type Export struct {
+	ContentType string
+	Filename    string
+
+	write func(w io.Writer) error
+}
+
+// Write streams the expenses to w.
+func (e *Export) Write(w io.Writer) error {
+	return e.write(w)
+}
+
+type ExportExpensesUseCase struct {
+	store data.Store
+}
+
+func NewExportExpensesUseCase(store data.Store) *ExportExpensesUseCase {
+	return &ExportExpensesUseCase{store: store}
+}
+
+// Execute checks an export of the expenses matching query. Paging is
+// ignored: an export holds every matching expense.
+func (uc *ExportExpensesUseCase) Execute(userID int64, query dto.ExpenseQueryDTO, options dto.ExportOptionsDTO) (*Export, error) {
+	filter, err := buildExpenseFilter(userID, query)
+	if err != nil {
+		return nil, err
+	}
+	filter.Limit = 0
+	filter.Offset = 0
+
+	layout, err := parseExportLayout(options)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+
+	format := ExportFormat(strings.ToLower(options.Format))
+	if options.Format == "" {
+		format = ExportCSV
+	}
+
+	switch format {
+	case ExportJSONL:
+		return &Export{
+			ContentType: "application/jsonl",
+			Filename:    "expenses.jsonl",
+			write: func(w io.Writer) error {
+				return uc.writeJSONL(w, filter)
+			},
+		}, nil
+	case ExportCSV, ExportXLSX:
+	default:
+		return nil, fmt.Errorf("%w: format must be csv, jsonl or xlsx", ErrInvalidExport)
+	}
+
+	names, err := uc.names(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == ExportXLSX {
+		return &Export{
+			ContentType: xlsx.ContentType,
+			Filename:    "expenses.xlsx",
+			write: func(w io.Writer) error {
+				return uc.writeXLSX(w, filter, layout, names)
+			},
+		}, nil
+	}
+
+	return &Export{
+		ContentType: "text/csv; charset=utf-8",
+		Filename:    "expenses.csv",
+		write: func(w io.Writer) error {
+			return uc.writeCSV(w, filter, layout, names)
+		},
+	}, nil
+}
+
+// exportLayout is how a CSV or XLSX export writes its values.
+type exportLayout struct {
+	locale.Format
+	dateLayout string
+	delimiter  rune
+}
+
+func parseExportLayout(options dto.ExportOptionsDTO) (exportLayout, error) {
+	layout := exportLayout{Format: locale.ISO, delimiter: ','}
+
+	if options.Locale != "" {
+		format, ok := locale.Lookup(options.Locale)
+		if !ok {
+			return layout, fmt.Errorf("unsupported locale %q", options.Locale)
+		}
+		layout.Format = format
+	}
+
+	switch options.DecimalSeparator {
+	case "":
+	case ".", ",":
+		layout.DecimalSeparator = options.DecimalSeparator
+	default:
+		return layout, errors.New(`decimal_separator must be "." or ","`)
+	}
+
+	if options.DateFormat != "" {
+		layout.DateFormat = options.DateFormat
+	}
+
+	dateLayout, err := locale.DateLayout(layout.DateFormat)
+	if err != nil {
+		return layout, err
+	}
+	layout.dateLayout = dateLayout
+
+	// A comma in the amounts calls for another delimiter, as spreadsheets
+	// in those locales expect.
+	if layout.DecimalSeparator == "," {
+		layout.delimiter = ';'
+	}
+
+	switch options.Delimiter {
+	case "":
+	case "tab", `\t`:
+		layout.delimiter = '\t'
+	default:
+		r, size := utf8.DecodeRuneInString(options.Delimiter)
+		if size != len(options.Delimiter) || r == '"' || r == '\r' || r == '\n' {
+			return layout, errors.New("delimiter must be a single character")
+		}
+		layout.delimiter = r
+	}
+
+	return layout, nil
+}
+
+// exportNames holds the names of the user's categories and accounts, read
+// before the expenses are streamed.
+type exportNames struct {
+	categories map[string]string
+	accounts   map[string]string
+}
+
+func (uc *ExportExpensesUseCase) names(userID int64) (exportNames, error) {
+	names := exportNames{categories: make(map[string]string), accounts: make(map[string]string)}
+
+	categories, err := uc.store.Categories.FindAll(userID)
+	if err != nil {
+		return names, fmt.Errorf("failed to list categories: %w", err)
+	}
+	for _, c := range categories {
+		names.categories[c.ID()] = c.Name()
+	}
+
+	accounts, err := uc.store.Accounts.FindAll(userID)
+	if err != nil {
+		return names, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	for _, a := range accounts {
+		names.accounts[a.Account.ID()] = a.Account.Name()
+	}
+
+	return names, nil
+}
+
+// spreadsheetText escapes text that a spreadsheet would otherwise run as a
+// formula, such as a description from a bank statement that starts with
+// "=HYPERLINK(", by starting it with an apostrophe.
+func spreadsheetText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func (uc *ExportExpensesUseCase) writeCSV(w io.Writer, filter data.ExpenseFilter, layout exportLayout, names exportNames) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = layout.delimiter
+
+	if err := writer.Write(exportHeader); err != nil {
+		return err
+	}
+
+	err := uc.store.Expenses.Stream(filter, func(e entity.Expense) error {
+		return writer.Write([]string{
+			e.Date().Format(layout.dateLayout),
+			spreadsheetText(e.Description()),
+			layout.Amount(money.Amount(e.Amount())),
+			e.Currency(),
+			string(e.ExpenseType()),
+			spreadsheetText(names.categories[e.CategoryID()]),
+			spreadsheetText(names.accounts[e.AccountID()]),
+			spreadsheetText(strings.Join(e.Tags(), ", ")),
+			e.ID(),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (uc *ExportExpensesUseCase) writeJSONL(w io.Writer, filter data.ExpenseFilter) error {
+	buffered := bufio.NewWriter(w)
+	encoder := json.NewEncoder(buffered)
+
+	err := uc.store.Expenses.Stream(filter, func(e entity.Expense) error {
+		return encoder.Encode(e.ToDTO())
+	})
+	if err != nil {
+		return err
+	}
+
+	return buffered.Flush()
+}
+
+func (uc *ExportExpensesUseCase) writeXLSX(w io.Writer, filter data.ExpenseFilter, layout exportLayout, names exportNames) error {
+	writer, err := xlsx.NewWriter(w, "Expenses", strings.ToLower(layout.DateFormat))
+	if err != nil {
+		return err
+	}
+
+	if err := writer.WriteHeader(exportHeader...); err != nil {
+		return err
+	}
+
+	err = uc.store.Expenses.Stream(filter, func(e entity.Expense) error {
+		return writer.WriteRow(
+			xlsx.Date(e.Date()),
+			xlsx.String(spreadsheetText(e.Description())),
+			xlsx.Number(money.Amount(e.Amount()).String()),
+			xlsx.String(e.Currency()),
+			xlsx.String(string(e.ExpenseType())),
+			xlsx.String(spreadsheetText(names.categories[e.CategoryID()])),
+			xlsx.String(spreadsheetText(names.accounts[e.AccountID()])),
+			xlsx.String(spreadsheetText(strings.Join(e.Tags(), ", "))),
+			xlsx.String(e.ID()),
+		)
+	})
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	accountEntity "github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExportStore(t *testing.T) (data.Store, *MockExpenseRepository) {
+	t.Helper()
+
+	account, err := accountEntity.NewAccount(1, "Checking", accountEntity.CheckingAccount, "EUR", 0)
+	require.NoError(t, err)
+
+	expense, err := entity.NewExpense(1, 123450, `Rent "April"`, time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), entity.FixedExpense)
+	require.NoError(t, err)
+	expense.SetID("e1")
+	expense.SetCategoryID("home")
+	expense.SetAccountID(account.ID())
+	require.NoError(t, expense.SetTags([]string{"flat", "monthly"}))
+
+	expenses := &MockExpenseRepository{expenses: []entity.Expense{*expense}}
+
+	return data.Store{
+		Expenses:   expenses,
+		Categories: &MockCategoryRepository{categories: map[string]int64{"home": 1}},
+		Accounts:   &MockAccountRepository{accounts: map[string]accountEntity.Account{account.ID(): *account}},
+	}, expenses
+}
+
+func export(t *testing.T, store data.Store, query dto.ExpenseQueryDTO, options dto.ExportOptionsDTO) (*Export, string) {
+	t.Helper()
+
+	result, err := NewExportExpensesUseCase(store).Execute(1, query, options)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, result.Write(&buf))
+
+	return result, buf.String()
+}
+
+func TestExportExpenses(t *testing.T) {
+	t.Run("CSV by default, with every matching expense", func(t *testing.T) {
+		store, expenses := newExportStore(t)
+
+		result, content := export(t, store, dto.ExpenseQueryDTO{ExpenseType: "fixed", Limit: 10, Offset: 20}, dto.ExportOptionsDTO{})
+
+		assert.Equal(t, "expenses.csv", result.Filename)
+		assert.Equal(t, "Date,Description,Amount,Currency,Type,Category,Account,Tags,ID\n"+
+			`2026-04-02,"Rent ""April""",1234.50,EUR,fixed,Category,Checking,"flat, monthly",e1`+"\n", content)
+
+		assert.Equal(t, entity.FixedExpense, expenses.lastFilter.ExpenseType)
+		assert.Zero(t, expenses.lastFilter.Limit, "Exports should not be paged")
+		assert.Zero(t, expenses.lastFilter.Offset)
+	})
+
+	t.Run("CSV for a locale", func(t *testing.T) {
+		store, _ := newExportStore(t)
+
+		_, content := export(t, store, dto.ExpenseQueryDTO{}, dto.ExportOptionsDTO{Locale: "de_DE"})
+		assert.Contains(t, content, `02.04.2026;"Rent ""April""";1234,50;EUR;`)
+
+		_, content = export(t, store, dto.ExpenseQueryDTO{}, dto.ExportOptionsDTO{Locale: "de-DE", DateFormat: "YYYY/MM/DD", Delimiter: "tab"})
+		assert.Contains(t, content, "2026/04/02\t\"Rent \"\"April\"\"\"\t1234,50\tEUR\t")
+	})
+
+	t.Run("JSON Lines", func(t *testing.T) {
+		store, _ := newExportStore(t)
+
+		result, content := export(t, store, dto.ExpenseQueryDTO{}, dto.ExportOptionsDTO{Format: "jsonl", Locale: "de-DE"})
+		assert.Equal(t, "application/jsonl", result.ContentType)
+
+		lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+		require.Len(t, lines, 1)
+
+		var expense dto.ExpenseDTO
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &expense))
+		assert.Equal(t, "e1", expense.ID)
+		assert.Equal(t, "2026-04-02", expense.Date, "JSON Lines should not be localized")
+		assert.Equal(t, []string{"flat", "monthly"}, expense.Tags)
+	})
+
+	t.Run("XLSX", func(t *testing.T) {
+		store, _ := newExportStore(t)
+
+		result, content := export(t, store, dto.ExpenseQueryDTO{}, dto.ExportOptionsDTO{Format: "XLSX", Locale: "en-US"})
+		assert.Equal(t, "expenses.xlsx", result.Filename)
+
+		archive, err := zip.NewReader(strings.NewReader(content), int64(len(content)))
+		require.NoError(t, err)
+
+		parts := make(map[string]string)
+		for _, f := range archive.File {
+			r, err := f.Open()
+			require.NoError(t, err)
+			b, err := io.ReadAll(r)
+			require.NoError(t, err)
+			parts[f.Name] = string(b)
+		}
+
+		assert.Contains(t, parts["xl/styles.xml"], `formatCode="mm/dd/yyyy"`)
+		assert.Contains(t, parts["xl/worksheets/sheet1.xml"], `<v>1234.50</v>`)
+		assert.Contains(t, parts["xl/worksheets/sheet1.xml"], `Rent &#34;April&#34;`)
+	})
+
+	t.Run("Text is not run as a formula", func(t *testing.T) {
+		store, expenses := newExportStore(t)
+		expenses.expenses[0].SetDescription(`=HYPERLINK("https://example.com","Refund")`)
+		require.NoError(t, expenses.expenses[0].SetTags([]string{"-10"}))
+
+		_, content := export(t, store, dto.ExpenseQueryDTO{}, dto.ExportOptionsDTO{})
+		assert.Contains(t, content, `,"'=HYPERLINK(""https://example.com"",""Refund"")",`)
+		assert.Contains(t, content, `,'-10,e1`)
+
+		_, content = export(t, store, dto.ExpenseQueryDTO{}, dto.ExportOptionsDTO{Format: "xlsx"})
+		archive, err := zip.NewReader(strings.NewReader(content), int64(len(content)))
+		require.NoError(t, err)
+		sheet, err := archive.Open("xl/worksheets/sheet1.xml")
+		require.NoError(t, err)
+		b, err := io.ReadAll(sheet)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `&#39;=HYPERLINK(`)
+	})
+
+	t.Run("Invalid options", func(t *testing.T) {
+		store, _ := newExportStore(t)
+
+		for _, options := range []dto.ExportOptionsDTO{
+			{Format: "pdf"},
+			{Locale: "xx-XX"},
+			{DecimalSeparator: "'"},
+			{DateFormat: "MMMM"},
+			{Delimiter: "::"},
+		} {
+			_, err := NewExportExpensesUseCase(store).Execute(1, dto.ExpenseQueryDTO{}, options)
+			assert.ErrorIs(t, err, ErrInvalidExport, "%+v", options)
+		}
+
+		_, err := NewExportExpensesUseCase(store).Execute(1, dto.ExpenseQueryDTO{Order: "sideways"}, dto.ExportOptionsDTO{})
+		assert.ErrorIs(t, err, ErrInvalidExpenseQuery)
+	})
+}
@@ -15,14 +15,14 @@ func NewGetExpenseUseCase(store data.Store) *GetExpenseUseCase {
 	return &GetExpenseUseCase{store: store}
 }
 
-func (uc *GetExpenseUseCase) Execute(id string) (result *dto.ExpenseDTO, err error) {
-	expense, err := uc.store.Expenses.FindByID(id)
+func (uc *GetExpenseUseCase) Execute(userID int64, id string) (result *dto.ExpenseDTO, err error) {
+	expense, err := uc.store.Expenses.FindByID(userID, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find expense by ID: %w", err)
 	}
 
 	if expense == nil {
-		return nil, fmt.Errorf("expense not found")
+		return nil, data.ErrExpenseNotFound
 	}
 
 	return expense.ToDTO(), nil
@@ -2,11 +2,24 @@ package usecase
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/MarioGN/finance-manager-api/data"
 	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	tagEntity "github.com/MarioGN/finance-manager-api/internal/tags/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
 )
 
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+var ErrInvalidExpenseQuery = appErrors.Validation("invalid expense query")
+
 type GetExpensesUseCase struct {
 	store data.Store
 }
@@ -17,20 +30,161 @@ func NewGetExpensesUseCase(store data.Store) *GetExpensesUseCase {
 	}
 }
 
-func (uc *GetExpensesUseCase) Execute() (result []dto.ExpenseDTO, err error) {
-	expenses, err := uc.store.Expenses.FindAll()
+func (uc *GetExpensesUseCase) Execute(userID int64, query dto.ExpenseQueryDTO) (result *dto.ExpenseListDTO, err error) {
+	filter, err := buildExpenseFilter(userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := uc.store.Expenses.FindAll(filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list expenses: %w", err)
 	}
 
-	if len(expenses) == 0 {
-		return []dto.ExpenseDTO{}, nil
+	total, err := uc.store.Expenses.Count(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count expenses: %w", err)
+	}
+
+	result = &dto.ExpenseListDTO{
+		Items:  make([]dto.ExpenseDTO, 0, len(expenses)),
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
 	}
 
 	for _, e := range expenses {
-		dto := e.ToDTO()
-		result = append(result, *dto)
+		result.Items = append(result.Items, *e.ToDTO())
 	}
 
 	return result, nil
 }
+
+func buildExpenseFilter(userID int64, query dto.ExpenseQueryDTO) (data.ExpenseFilter, error) {
+	filter := data.ExpenseFilter{
+		UserID:      userID,
+		CategoryID:  query.CategoryID,
+		AccountID:   query.AccountID,
+		Description: strings.TrimSpace(query.Description),
+		SortField:   data.SortByDate,
+		SortDesc:    true,
+		Limit:       DefaultPageSize,
+		Offset:      query.Offset,
+	}
+
+	var err error
+
+	if filter.From, err = parseOptionalDate(query.From); err != nil {
+		return filter, fmt.Errorf("%w: from must be a YYYY-MM-DD date", ErrInvalidExpenseQuery)
+	}
+
+	if filter.To, err = parseOptionalDate(query.To); err != nil {
+		return filter, fmt.Errorf("%w: to must be a YYYY-MM-DD date", ErrInvalidExpenseQuery)
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return filter, fmt.Errorf("%w: from must not be after to", ErrInvalidExpenseQuery)
+	}
+
+	if query.ExpenseType != "" {
+		filter.ExpenseType = entity.ExpenseType(query.ExpenseType)
+		if !filter.ExpenseType.IsValid() {
+			return filter, fmt.Errorf("%w: unknown expense_type %q", ErrInvalidExpenseQuery, query.ExpenseType)
+		}
+	}
+
+	if filter.AnyTags, err = parseTagList(query.Tag); err != nil {
+		return filter, fmt.Errorf("%w: tag: %w", ErrInvalidExpenseQuery, err)
+	}
+
+	if filter.AllTags, err = parseTagList(query.TagsAll); err != nil {
+		return filter, fmt.Errorf("%w: tags_all: %w", ErrInvalidExpenseQuery, err)
+	}
+
+	if filter.AmountMin, err = parseOptionalAmount(query.AmountMin); err != nil {
+		return filter, fmt.Errorf("%w: amount_min must be a decimal amount", ErrInvalidExpenseQuery)
+	}
+
+	if filter.AmountMax, err = parseOptionalAmount(query.AmountMax); err != nil {
+		return filter, fmt.Errorf("%w: amount_max must be a decimal amount", ErrInvalidExpenseQuery)
+	}
+
+	if query.Sort != "" {
+		filter.SortField = data.ExpenseSortField(query.Sort)
+		if !filter.SortField.IsValid() {
+			return filter, fmt.Errorf("%w: cannot sort by %q", ErrInvalidExpenseQuery, query.Sort)
+		}
+	}
+
+	switch strings.ToLower(query.Order) {
+	case "", "desc":
+	case "asc":
+		filter.SortDesc = false
+	default:
+		return filter, fmt.Errorf("%w: order must be asc or desc", ErrInvalidExpenseQuery)
+	}
+
+	if query.Limit < 0 || query.Limit > MaxPageSize {
+		return filter, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidExpenseQuery, MaxPageSize)
+	}
+	if query.Limit > 0 {
+		filter.Limit = query.Limit
+	}
+
+	if query.Offset < 0 {
+		return filter, fmt.Errorf("%w: offset must not be negative", ErrInvalidExpenseQuery)
+	}
+
+	return filter, nil
+}
+
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
+
+// parseTagList accepts repeated and comma-separated tag names and returns
+// them normalized and without case-insensitive duplicates.
+func parseTagList(values []string) ([]string, error) {
+	var tags []string
+	seen := make(map[string]bool)
+
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			name, err := tagEntity.NormalizeName(name)
+			if err != nil {
+				return nil, err
+			}
+
+			key := strings.ToLower(name)
+			if !seen[key] {
+				seen[key] = true
+				tags = append(tags, name)
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+func parseOptionalAmount(value string) (*int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, err := money.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	cents := int64(amount)
+	return &cents, nil
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockExpenseRepository implements data.ExpenseRepository for testing
+type MockExpenseRepository struct {
+	data.ExpenseRepository
+
+	expenses   []entity.Expense
+	total      int64
+	lastFilter data.ExpenseFilter
+}
+
+func (m *MockExpenseRepository) FindAll(filter data.ExpenseFilter) ([]entity.Expense, error) {
+	m.lastFilter = filter
+	return m.expenses, nil
+}
+
+func (m *MockExpenseRepository) Stream(filter data.ExpenseFilter, fn func(expense entity.Expense) error) error {
+	m.lastFilter = filter
+	for _, e := range m.expenses {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MockExpenseRepository) Count(filter data.ExpenseFilter) (int64, error) {
+	return m.total, nil
+}
+
+func TestGetExpenses_BuildsFilterFromQuery(t *testing.T) {
+	mockRepo := &MockExpenseRepository{total: 12}
+	uc := NewGetExpensesUseCase(data.Store{Expenses: mockRepo})
+
+	result, err := uc.Execute(7, dto.ExpenseQueryDTO{
+		From:        "2026-01-01",
+		To:          "2026-01-31",
+		ExpenseType: "variable",
+		AmountMin:   "0.29",
+		Description: "  market ",
+		Sort:        "amount",
+		Order:       "asc",
+		Limit:       10,
+		Offset:      10,
+	})
+	require.NoError(t, err)
+
+	filter := mockRepo.lastFilter
+	assert.Equal(t, int64(7), filter.UserID)
+	assert.Equal(t, "2026-01-01", filter.From.Format("2006-01-02"))
+	assert.Equal(t, "2026-01-31", filter.To.Format("2006-01-02"))
+	assert.Equal(t, entity.VariableExpense, filter.ExpenseType)
+	require.NotNil(t, filter.AmountMin)
+	assert.Equal(t, int64(29), *filter.AmountMin)
+	assert.Nil(t, filter.AmountMax)
+	assert.Equal(t, "market", filter.Description)
+	assert.Equal(t, data.SortByAmount, filter.SortField)
+	assert.False(t, filter.SortDesc)
+
+	assert.Equal(t, int64(12), result.Total)
+	assert.Equal(t, 10, result.Limit)
+	assert.Equal(t, 10, result.Offset)
+	assert.NotNil(t, result.Items, "Items should be an empty list, not null")
+}
+
+func TestGetExpenses_Defaults(t *testing.T) {
+	mockRepo := &MockExpenseRepository{}
+	uc := NewGetExpensesUseCase(data.Store{Expenses: mockRepo})
+
+	_, err := uc.Execute(7, dto.ExpenseQueryDTO{})
+	require.NoError(t, err)
+
+	assert.Equal(t, data.SortByDate, mockRepo.lastFilter.SortField)
+	assert.True(t, mockRepo.lastFilter.SortDesc, "Newest expenses should come first")
+	assert.Equal(t, DefaultPageSize, mockRepo.lastFilter.Limit)
+	assert.Zero(t, mockRepo.lastFilter.Offset)
+}
+
+func TestGetExpenses_TagFilters(t *testing.T) {
+	mockRepo := &MockExpenseRepository{}
+	uc := NewGetExpensesUseCase(data.Store{Expenses: mockRepo})
+
+	_, err := uc.Execute(7, dto.ExpenseQueryDTO{
+		Tag:     []string{"travel,Reimbursable", " TRAVEL "},
+		TagsAll: []string{"vacation-2026"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"travel", "Reimbursable"}, mockRepo.lastFilter.AnyTags, "Tags should be split on commas and de-duplicated")
+	assert.Equal(t, []string{"vacation-2026"}, mockRepo.lastFilter.AllTags)
+}
+
+func TestGetExpenses_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query dto.ExpenseQueryDTO
+	}{
+		{name: "Malformed from", query: dto.ExpenseQueryDTO{From: "01/02/2026"}},
+		{name: "From after to", query: dto.ExpenseQueryDTO{From: "2026-02-01", To: "2026-01-01"}},
+		{name: "Unknown expense type", query: dto.ExpenseQueryDTO{ExpenseType: "luxury"}},
+		{name: "Non-numeric amount", query: dto.ExpenseQueryDTO{AmountMax: "lots"}},
+		{name: "Unknown sort field", query: dto.ExpenseQueryDTO{Sort: "id; DROP TABLE expenses"}},
+		{name: "Trash-only sort field", query: dto.ExpenseQueryDTO{Sort: "deleted_at"}},
+		{name: "Unknown order", query: dto.ExpenseQueryDTO{Order: "sideways"}},
+		{name: "Limit too large", query: dto.ExpenseQueryDTO{Limit: MaxPageSize + 1}},
+		{name: "Negative offset", query: dto.ExpenseQueryDTO{Offset: -1}},
+		{name: "Empty tag", query: dto.ExpenseQueryDTO{Tag: []string{"travel,"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewGetExpensesUseCase(data.Store{Expenses: &MockExpenseRepository{}})
+
+			result, err := uc.Execute(7, tt.query)
+
+			assert.ErrorIs(t, err, ErrInvalidExpenseQuery)
+			assert.Nil(t, result)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/jsonpatch"
+	"github.com/MarioGN/finance-manager-api/pkg/validation"
+)
+
+// PatchFormat is the kind of patch document a PATCH request carries.
+type PatchFormat string
+
+const (
+	// MergePatch is a JSON Merge Patch (RFC 7396): an object with the
+	// members to change, and null for the ones to clear.
+	MergePatch PatchFormat = "merge-patch"
+	// JSONPatch is a JSON Patch (RFC 6902): a list of operations.
+	JSONPatch PatchFormat = "json-patch"
+)
+
+var (
+	ErrInvalidPatch = appErrors.Validation("invalid patch")
+	// ErrPatchConflict is a well-formed patch that does not fit the expense
+	// as it is now, such as a failed test operation.
+	ErrPatchConflict = appErrors.Conflict("patch does not apply")
+	ErrReadOnlyField = errors.New("field cannot be changed")
+)
+
+type PatchExpenseUseCase struct {
+	store data.Store
+}
+
+func NewPatchExpenseUseCase(store data.Store) *PatchExpenseUseCase {
+	return &PatchExpenseUseCase{store: store}
+}
+
+// Execute applies patch to the expense as GET returns it and saves the
+// result the way a PUT of the whole expense would, so only the values the
+// patch touches change but all of them are checked again. Like a PUT, it
+// only applies to the given version, and version 0 matches any.
+func (uc *PatchExpenseUseCase) Execute(userID int64, id string, version int64, format PatchFormat, patch []byte) (*dto.ExpenseDTO, error) {
+	dbExpense, err := uc.store.Expenses.FindByID(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbExpense == nil {
+		return nil, data.ErrExpenseNotFound
+	}
+
+	if err := checkVersion(dbExpense, version); err != nil {
+		return nil, err
+	}
+
+	current := dbExpense.ToDTO()
+	doc, err := json.Marshal(current)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode expense: %w", err)
+	}
+
+	switch format {
+	case MergePatch:
+		doc, err = jsonpatch.Merge(doc, patch)
+	case JSONPatch:
+		doc, err = jsonpatch.Apply(doc, patch)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidPatch, format)
+	}
+	if errors.Is(err, jsonpatch.ErrPathNotFound) || errors.Is(err, jsonpatch.ErrTestFailed) {
+		return nil, fmt.Errorf("%w: %w", ErrPatchConflict, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+	}
+
+	input, err := decodePatched(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var readOnly []error
+	if input.ID != current.ID {
+		readOnly = append(readOnly, appErrors.Field("/id", ErrReadOnlyField))
+	}
+	if input.ExternalID != current.ExternalID {
+		readOnly = append(readOnly, appErrors.Field("/external_id", ErrReadOnlyField))
+	}
+	if input.DeletedAt != current.DeletedAt {
+		readOnly = append(readOnly, appErrors.Field("/deleted_at", ErrReadOnlyField))
+	}
+	if len(readOnly) > 0 {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, errors.Join(readOnly...))
+	}
+
+	// The currency follows the account unless the patch sets both.
+	if input.AccountID != current.AccountID && input.Currency == current.Currency {
+		input.Currency = ""
+	}
+
+	return updateExpense(uc.store, userID, dbExpense, *input)
+}
+
+// decodePatched reads the patched expense, reporting a member of the wrong
+// type or one an expense does not have at its JSON pointer. An amount that
+// cannot be read is left for validation to report.
+func decodePatched(doc []byte) (*dto.ExpenseDTO, error) {
+	// An expense decodes itself, out of reach of DisallowUnknownFields, so
+	// its members are checked on a copy without its methods first.
+	type fields dto.ExpenseDTO
+	members := struct {
+		*fields
+		Amount json.RawMessage `json:"amount"`
+	}{fields: &fields{}}
+
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(&members)
+	if err == nil {
+		var input dto.ExpenseDTO
+		if err := json.Unmarshal(doc, &input); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+		}
+		return &input, nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		pointer := "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		err = appErrors.Field(pointer, fmt.Errorf("unexpected JSON %s", typeErr.Value))
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		name := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		err = appErrors.Field(validation.Pointer(name), errors.New("unknown field"))
+	}
+
+	return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+}
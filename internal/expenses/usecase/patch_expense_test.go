@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	accountEntity "github.com/MarioGN/finance-manager-api/internal/accounts/entity"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchExpense(t *testing.T) {
+	dollars, err := accountEntity.NewAccount(1, "Dollars", accountEntity.CheckingAccount, "USD", 0)
+	require.NoError(t, err)
+
+	existing, err := entity.NewExpense(1, 1250, "Lunch", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), entity.VariableExpense)
+	require.NoError(t, err)
+	require.NoError(t, existing.SetCurrency("EUR"))
+	require.NoError(t, existing.SetTags([]string{"food", "work"}))
+
+	tests := []struct {
+		name   string
+		format PatchFormat
+		patch  string
+		check  func(t *testing.T, updated *entity.Expense)
+	}{
+		{
+			name: "Merge patch changes only the given members", format: MergePatch,
+			patch: `{"amount":"20.00","tags":null}`,
+			check: func(t *testing.T, updated *entity.Expense) {
+				assert.Equal(t, int64(2000), updated.Amount())
+				assert.Equal(t, "Lunch", updated.Description())
+				assert.Equal(t, "2026-03-01", updated.Date().Format("2006-01-02"))
+				assert.Empty(t, updated.Tags())
+			},
+		},
+		{
+			name: "JSON Patch applies its operations", format: JSONPatch,
+			patch: `[{"op":"test","path":"/description","value":"Lunch"},{"op":"replace","path":"/description","value":"Team lunch"},{"op":"add","path":"/tags/-","value":"team"}]`,
+			check: func(t *testing.T, updated *entity.Expense) {
+				assert.Equal(t, int64(1250), updated.Amount())
+				assert.Equal(t, "Team lunch", updated.Description())
+				assert.Equal(t, []string{"food", "team", "work"}, updated.Tags())
+			},
+		},
+		{
+			name: "The currency follows a new account", format: MergePatch,
+			patch: `{"account_id":"` + dollars.ID() + `"}`,
+			check: func(t *testing.T, updated *entity.Expense) {
+				assert.Equal(t, dollars.ID(), updated.AccountID())
+				assert.Equal(t, "USD", updated.Currency())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expenses := &MockUpdatingExpenseRepository{expense: *existing}
+			store := data.Store{
+				Expenses: expenses,
+				Accounts: &MockAccountRepository{accounts: map[string]accountEntity.Account{dollars.ID(): *dollars}},
+				Users:    &MockUserRepository{baseCurrency: "EUR"},
+				Budgets:  &MockBudgetRepository{},
+			}
+
+			res, err := NewPatchExpenseUseCase(store).Execute(1, existing.ID(), existing.Version(), tt.format, []byte(tt.patch))
+
+			require.NoError(t, err)
+			assert.Equal(t, existing.Version()+1, res.Version, "The new version should be returned")
+			require.NotNil(t, expenses.updated)
+			tt.check(t, expenses.updated)
+		})
+	}
+}
+
+func TestPatchExpense_Errors(t *testing.T) {
+	existing, err := entity.NewExpense(1, 1250, "Lunch", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), entity.VariableExpense)
+	require.NoError(t, err)
+	require.NoError(t, existing.SetCurrency("EUR"))
+
+	tests := []struct {
+		name        string
+		id          string
+		version     int64
+		format      PatchFormat
+		patch       string
+		expectedErr error
+		kind        appErrors.Kind
+		pointers    []string
+	}{
+		{name: "Unknown expense", id: "missing", format: MergePatch, patch: `{}`,
+			expectedErr: data.ErrExpenseNotFound, kind: appErrors.KindNotFound},
+		{name: "Stale version", version: 2, format: MergePatch, patch: `{}`,
+			expectedErr: data.ErrExpenseChanged, kind: appErrors.KindPreconditionFailed},
+		{name: "Malformed merge patch", format: MergePatch, patch: `{"amount":`,
+			expectedErr: ErrInvalidPatch, kind: appErrors.KindValidation},
+		{name: "Unknown operation", format: JSONPatch, patch: `[{"op":"swap","path":"/amount"}]`,
+			expectedErr: ErrInvalidPatch, kind: appErrors.KindValidation},
+		{name: "Failed test", format: JSONPatch, patch: `[{"op":"test","path":"/description","value":"Dinner"}]`,
+			expectedErr: ErrPatchConflict, kind: appErrors.KindConflict},
+		{name: "Missing path", format: JSONPatch, patch: `[{"op":"remove","path":"/category_id"}]`,
+			expectedErr: ErrPatchConflict, kind: appErrors.KindConflict},
+		{name: "Wrong type", format: MergePatch, patch: `{"description":42}`,
+			expectedErr: ErrInvalidPatch, kind: appErrors.KindValidation, pointers: []string{"/description"}},
+		{name: "Malformed amount", format: MergePatch, patch: `{"amount":"12.345","date":"tomorrow"}`,
+			expectedErr: ErrInvalidExpense, kind: appErrors.KindValidation, pointers: []string{"/amount", "/date"}},
+		{name: "Unknown field", format: MergePatch, patch: `{"descripton":"Dinner"}`,
+			expectedErr: ErrInvalidPatch, kind: appErrors.KindValidation, pointers: []string{"/descripton"}},
+		{name: "Read-only fields", format: MergePatch, patch: `{"id":"other","external_id":"bank-1"}`,
+			expectedErr: ErrReadOnlyField, kind: appErrors.KindValidation, pointers: []string{"/id", "/external_id"}},
+		{name: "Rules of a new expense", format: JSONPatch, patch: `[{"op":"remove","path":"/date"},{"op":"replace","path":"/amount","value":"0"}]`,
+			expectedErr: ErrInvalidExpense, kind: appErrors.KindValidation, pointers: []string{"/amount", "/date"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expenses := &MockUpdatingExpenseRepository{expense: *existing}
+			store := data.Store{Expenses: expenses, Users: &MockUserRepository{baseCurrency: "EUR"}, Budgets: &MockBudgetRepository{}}
+
+			id := existing.ID()
+			if tt.id != "" {
+				id = tt.id
+			}
+
+			_, err := NewPatchExpenseUseCase(store).Execute(1, id, tt.version, tt.format, []byte(tt.patch))
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.kind, appErrors.KindOf(err))
+
+			var pointers []string
+			for _, field := range appErrors.Fields(err) {
+				pointers = append(pointers, field.Pointer)
+			}
+			assert.Equal(t, tt.pointers, pointers)
+			assert.Nil(t, expenses.updated, "Expense should not be updated")
+		})
+	}
+}
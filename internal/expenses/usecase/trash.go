@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+)
+
+type GetTrashUseCase struct {
+	store data.Store
+}
+
+func NewGetTrashUseCase(store data.Store) *GetTrashUseCase {
+	return &GetTrashUseCase{store: store}
+}
+
+// Execute lists the expenses in the trash with the filters of the expense
+// list, which can also be sorted by deleted_at. The most recently deleted
+// come first unless another order is asked for.
+func (uc *GetTrashUseCase) Execute(userID int64, query dto.ExpenseQueryDTO) (*dto.ExpenseListDTO, error) {
+	byDeletedAt := query.Sort == "" || query.Sort == string(data.SortByDeletedAt)
+	if byDeletedAt {
+		query.Sort = ""
+	}
+
+	filter, err := buildExpenseFilter(userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Trashed = true
+	if byDeletedAt {
+		filter.SortField = data.SortByDeletedAt
+	}
+
+	expenses, err := uc.store.Expenses.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trashed expenses: %w", err)
+	}
+
+	total, err := uc.store.Expenses.Count(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count trashed expenses: %w", err)
+	}
+
+	result := &dto.ExpenseListDTO{
+		Items:  make([]dto.ExpenseDTO, 0, len(expenses)),
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+
+	for _, e := range expenses {
+		result.Items = append(result.Items, *e.ToDTO())
+	}
+
+	return result, nil
+}
+
+type RestoreExpenseUseCase struct {
+	store data.Store
+}
+
+func NewRestoreExpenseUseCase(store data.Store) *RestoreExpenseUseCase {
+	return &RestoreExpenseUseCase{store: store}
+}
+
+// Execute takes the expense out of the trash and returns it as it is now.
+func (uc *RestoreExpenseUseCase) Execute(userID int64, id string) (*dto.ExpenseDTO, error) {
+	if err := uc.store.Expenses.Restore(userID, id); err != nil {
+		return nil, fmt.Errorf("failed to restore expense: %w", err)
+	}
+
+	dbExpense, err := uc.store.Expenses.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find expense by ID: %w", err)
+	}
+
+	checkBudgets(uc.store, userID, dbExpense.Date())
+
+	return dbExpense.ToDTO(), nil
+}
+
+type PurgeTrashUseCase struct {
+	store     data.Store
+	retention time.Duration
+}
+
+// NewPurgeTrashUseCase returns a use case that deletes expenses for good
+// once they have been in the trash for longer than retention.
+func NewPurgeTrashUseCase(store data.Store, retention time.Duration) *PurgeTrashUseCase {
+	return &PurgeTrashUseCase{store: store, retention: retention}
+}
+
+// Execute purges every user's expenses trashed before the retention window
+// that ends at the given time, and returns how many were purged.
+func (uc *PurgeTrashUseCase) Execute(at time.Time) (int64, error) {
+	purged, err := uc.store.Expenses.Purge(at.Add(-uc.retention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trashed expenses: %w", err)
+	}
+
+	return purged, nil
+}
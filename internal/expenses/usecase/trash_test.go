@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTrashingExpenseRepository holds one expense and records what is
+// trashed and purged
+type MockTrashingExpenseRepository struct {
+	MockUpdatingExpenseRepository
+
+	trashed     *entity.Expense
+	purgeBefore time.Time
+}
+
+func (m *MockTrashingExpenseRepository) Trash(expense entity.Expense) error {
+	m.trashed = &expense
+	return nil
+}
+
+func (m *MockTrashingExpenseRepository) Purge(before time.Time) (int64, error) {
+	m.purgeBefore = before
+	return 2, nil
+}
+
+func TestDeleteExpense_MovesToTrash(t *testing.T) {
+	deletedAt := time.Date(2026, 3, 5, 9, 30, 0, 0, time.UTC)
+	now = func() time.Time { return deletedAt }
+	t.Cleanup(func() { now = time.Now })
+
+	existing, err := entity.NewExpense(1, 1250, "Lunch", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), entity.VariableExpense)
+	require.NoError(t, err)
+
+	expenses := &MockTrashingExpenseRepository{MockUpdatingExpenseRepository: MockUpdatingExpenseRepository{expense: *existing}}
+	store := data.Store{Expenses: expenses, Budgets: &MockBudgetRepository{}}
+
+	err = NewDeleteExpenseUseCase(store).Execute(1, existing.ID(), 2)
+	assert.ErrorIs(t, err, data.ErrExpenseChanged)
+	assert.Nil(t, expenses.trashed, "A stale version should not be trashed")
+
+	require.NoError(t, NewDeleteExpenseUseCase(store).Execute(1, existing.ID(), existing.Version()))
+	require.NotNil(t, expenses.trashed)
+	assert.Equal(t, deletedAt, expenses.trashed.DeletedAt())
+}
+
+func TestPurgeTrash(t *testing.T) {
+	expenses := &MockTrashingExpenseRepository{}
+	at := time.Date(2026, 4, 30, 12, 0, 0, 0, time.UTC)
+
+	purged, err := NewPurgeTrashUseCase(data.Store{Expenses: expenses}, 30*24*time.Hour).Execute(at)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+	assert.Equal(t, time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC), expenses.purgeBefore)
+}
+
+func TestGetTrash_Sort(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    dto.ExpenseQueryDTO
+		expected data.ExpenseSortField
+		desc     bool
+	}{
+		{name: "Most recently deleted first", query: dto.ExpenseQueryDTO{}, expected: data.SortByDeletedAt, desc: true},
+		{name: "Deleted at, oldest first", query: dto.ExpenseQueryDTO{Sort: "deleted_at", Order: "asc"}, expected: data.SortByDeletedAt},
+		{name: "Fields of the expense list", query: dto.ExpenseQueryDTO{Sort: "amount"}, expected: data.SortByAmount, desc: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockExpenseRepository{}
+
+			_, err := NewGetTrashUseCase(data.Store{Expenses: mockRepo}).Execute(7, tt.query)
+			require.NoError(t, err)
+
+			assert.True(t, mockRepo.lastFilter.Trashed)
+			assert.Equal(t, tt.expected, mockRepo.lastFilter.SortField)
+			assert.Equal(t, tt.desc, mockRepo.lastFilter.SortDesc)
+		})
+	}
+}
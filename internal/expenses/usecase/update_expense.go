@@ -1,12 +1,13 @@
 package usecase
 
 import (
+	"errors"
 	"fmt"
-	"time"
 
 	"github.com/MarioGN/finance-manager-api/data"
 	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
 	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
 )
 
 type UpdateExpenseUseCase struct {
@@ -17,37 +18,77 @@ func NewUpdateExpenseUseCase(store data.Store) *UpdateExpenseUseCase {
 	return &UpdateExpenseUseCase{store: store}
 }
 
-func (uc *UpdateExpenseUseCase) Execute(id string, input dto.ExpenseDTO) (result *dto.ExpenseDTO, err error) {
-	dbExpense, err := uc.store.Expenses.FindByID(id)
+// Execute replaces the expense with input if it still has the given
+// version. Version 0 matches any version.
+func (uc *UpdateExpenseUseCase) Execute(userID int64, id string, version int64, input dto.ExpenseDTO) (*dto.ExpenseDTO, error) {
+	dbExpense, err := uc.store.Expenses.FindByID(userID, id)
 	if err != nil {
 		return nil, err
 	}
 
 	if dbExpense == nil {
-		return nil, fmt.Errorf("expense not found")
+		return nil, data.ErrExpenseNotFound
 	}
 
-	err = dbExpense.SetAmount(int64(input.Amount * 100))
-	if err != nil {
-		return nil, fmt.Errorf("invalid amount: %w", err)
+	if err := checkVersion(dbExpense, version); err != nil {
+		return nil, err
 	}
 
-	date, err := time.Parse("2006-01-02", input.Date)
+	return updateExpense(uc.store, userID, dbExpense, input)
+}
+
+// updateExpense replaces every value of dbExpense with those of input, checks
+// them as a new expense would be and saves it.
+func updateExpense(store data.Store, userID int64, dbExpense *entity.Expense, input dto.ExpenseDTO) (*dto.ExpenseDTO, error) {
+	date, err := validateExpense(input)
 	if err != nil {
-		return nil, fmt.Errorf("invalid date format: %w", err)
+		return nil, err
 	}
-	dbExpense.SetDate(date)
 
-	err = dbExpense.SetExpenseType(entity.ExpenseType(input.ExpenseType))
+	previousDate := dbExpense.Date()
+	if err := errors.Join(
+		dbExpense.SetAmount(int64(input.Amount)),
+		dbExpense.SetDate(date),
+		dbExpense.SetExpenseType(entity.ExpenseType(input.ExpenseType)),
+	); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpense, err)
+	}
+	dbExpense.SetDescription(input.Description)
+
+	if err := checkCategory(store, userID, input.CategoryID); err != nil {
+		return nil, err
+	}
+	dbExpense.SetCategoryID(input.CategoryID)
+
+	currency, err := accountCurrency(store, userID, input.AccountID, input.Currency, dbExpense.Currency())
 	if err != nil {
-		return nil, fmt.Errorf("invalid expense type: %w", err)
+		return nil, err
+	}
+	dbExpense.SetAccountID(input.AccountID)
+	if err := dbExpense.SetCurrency(currency); err != nil {
+		return nil, appErrors.Field("/currency", fmt.Errorf("%w: %w", ErrInvalidCurrency, err))
 	}
 
-	dbExpense.SetDescription(input.Description)
+	if err := dbExpense.SetTags(input.Tags); err != nil {
+		return nil, appErrors.Field("/tags", fmt.Errorf("%w: %w", ErrInvalidTags, err))
+	}
 
-	if err := uc.store.Expenses.Update(*dbExpense); err != nil {
+	if err := store.Expenses.Update(*dbExpense); err != nil {
 		return nil, fmt.Errorf("failed to save expense: %w", err)
 	}
+	dbExpense.SetVersion(dbExpense.Version() + 1)
+
+	checkBudgets(store, userID, previousDate, dbExpense.Date())
 
 	return dbExpense.ToDTO(), nil
 }
+
+// checkVersion refuses a change made on another version of the expense than
+// the current one, before any work is done. The repository checks the
+// version again when saving, in case the expense changes in between.
+func checkVersion(expense *entity.Expense, version int64) error {
+	if version != 0 && version != expense.Version() {
+		return fmt.Errorf("%w: %s", data.ErrExpenseChanged, expense.ID())
+	}
+	return nil
+}
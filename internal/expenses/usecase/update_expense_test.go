@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUpdatingExpenseRepository holds one expense and records updates
+type MockUpdatingExpenseRepository struct {
+	data.ExpenseRepository
+
+	expense entity.Expense
+	updated *entity.Expense
+}
+
+func (m *MockUpdatingExpenseRepository) FindByID(userID int64, id string) (*entity.Expense, error) {
+	if id != m.expense.ID() || userID != m.expense.UserID() {
+		return nil, fmt.Errorf("%w: %s", data.ErrExpenseNotFound, id)
+	}
+	expense := m.expense
+	return &expense, nil
+}
+
+func (m *MockUpdatingExpenseRepository) Update(expense entity.Expense) error {
+	m.updated = &expense
+	return nil
+}
+
+func TestUpdateExpense_Errors(t *testing.T) {
+	existing, err := entity.NewExpense(1, 1250, "Lunch", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), entity.VariableExpense)
+	require.NoError(t, err)
+
+	valid := dto.ExpenseDTO{Amount: 1500, Description: "Dinner", Date: "2026-03-02", ExpenseType: "variable"}
+
+	tests := []struct {
+		name        string
+		id          string
+		version     int64
+		modify      func(input *dto.ExpenseDTO)
+		expectedErr error
+		kind        appErrors.Kind
+		pointers    []string
+	}{
+		{name: "Unknown expense", id: "missing", expectedErr: data.ErrExpenseNotFound, kind: appErrors.KindNotFound},
+		{name: "Stale version", version: 2, expectedErr: data.ErrExpenseChanged, kind: appErrors.KindPreconditionFailed},
+		{name: "Non-positive amount", modify: func(input *dto.ExpenseDTO) { input.Amount = 0 },
+			expectedErr: entity.ErrInvalidAmount, kind: appErrors.KindValidation, pointers: []string{"/amount"}},
+		{name: "Malformed date", modify: func(input *dto.ExpenseDTO) { input.Date = "02/03/2026" },
+			expectedErr: entity.ErrInvalidDate, kind: appErrors.KindValidation, pointers: []string{"/date"}},
+		{name: "Unknown expense type", modify: func(input *dto.ExpenseDTO) { input.ExpenseType = "sometimes" },
+			expectedErr: entity.ErrInvalidExpenseType, kind: appErrors.KindValidation, pointers: []string{"/expense_type"}},
+		{
+			name: "Unreadable amount with other violations",
+			modify: func(input *dto.ExpenseDTO) {
+				*input = dto.ExpenseDTO{}
+				require.NoError(t, json.Unmarshal([]byte(`{"amount":12.5,"description":"Dinner","date":"2026-03-02","expense_type":"sometimes"}`), input))
+			},
+			expectedErr: money.ErrInvalidAmount, kind: appErrors.KindValidation, pointers: []string{"/amount", "/expense_type"},
+		},
+		{
+			name: "Every violation at once",
+			modify: func(input *dto.ExpenseDTO) {
+				*input = dto.ExpenseDTO{Amount: -5, Description: strings.Repeat("x", entity.MaxDescriptionLength+1), Tags: []string{"ok", " "}}
+			},
+			expectedErr: ErrInvalidExpense, kind: appErrors.KindValidation,
+			pointers: []string{"/amount", "/description", "/date", "/expense_type", "/tags/1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expenses := &MockUpdatingExpenseRepository{expense: *existing}
+			store := data.Store{Expenses: expenses, Users: &MockUserRepository{baseCurrency: "EUR"}, Budgets: &MockBudgetRepository{}}
+
+			id := existing.ID()
+			if tt.id != "" {
+				id = tt.id
+			}
+			input := valid
+			if tt.modify != nil {
+				tt.modify(&input)
+			}
+
+			_, err := NewUpdateExpenseUseCase(store).Execute(1, id, tt.version, input)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.kind, appErrors.KindOf(err))
+
+			var pointers []string
+			for _, field := range appErrors.Fields(err) {
+				pointers = append(pointers, field.Pointer)
+			}
+			assert.Equal(t, tt.pointers, pointers)
+			assert.Nil(t, expenses.updated, "Expense should not be updated")
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package dto
+
+import "github.com/MarioGN/finance-manager-api/pkg/money"
+
+// CSVMappingDTO describes how to read a bank statement. Columns are named by
+// their header, or by their 1-based position when the file has no header.
+type CSVMappingDTO struct {
+	Delimiter         string `form:"delimiter" query:"delimiter" json:"delimiter,omitempty"`
+	NoHeader          bool   `form:"no_header" query:"no_header" json:"no_header,omitempty"`
+	DateColumn        string `form:"date_column" query:"date_column" json:"date_column"`
+	DateFormat        string `form:"date_format" query:"date_format" json:"date_format,omitempty"`
+	AmountColumn      string `form:"amount_column" query:"amount_column" json:"amount_column"`
+	AmountSign        string `form:"amount_sign" query:"amount_sign" json:"amount_sign,omitempty"`
+	DecimalSeparator  string `form:"decimal_separator" query:"decimal_separator" json:"decimal_separator,omitempty"`
+	DescriptionColumn string `form:"description_column" query:"description_column" json:"description_column"`
+}
+
+type ImportRowDTO struct {
+	Line        int          `json:"line"`
+	Date        string       `json:"date,omitempty"`
+	Amount      money.Amount `json:"amount"`
+	Description string       `json:"description"`
+	Hash        string       `json:"hash,omitempty"`
+	ExternalID  string       `json:"external_id,omitempty"`
+	Currency    string       `json:"currency,omitempty"`
+	ExpenseType string       `json:"expense_type,omitempty"`
+	Status      string       `json:"status"`
+	Error       string       `json:"error,omitempty"`
+	ExpenseID   string       `json:"expense_id,omitempty"`
+}
+
+type ImportSummaryDTO struct {
+	Rows       int `json:"rows"`
+	New        int `json:"new"`
+	Duplicates int `json:"duplicates"`
+	Invalid    int `json:"invalid"`
+	Skipped    int `json:"skipped"`
+	Imported   int `json:"imported"`
+}
+
+// ImportDTO is a parsed statement. Until it is committed it is a preview and
+// no expense exists.
+type ImportDTO struct {
+	ID          string           `json:"id"`
+	Source      string           `json:"source"`
+	Filename    string           `json:"filename,omitempty"`
+	Status      string           `json:"status"`
+	CreatedAt   string           `json:"created_at"`
+	CommittedAt string           `json:"committed_at,omitempty"`
+	Summary     ImportSummaryDTO `json:"summary"`
+	Rows        []ImportRowDTO   `json:"rows"`
+}
+
+// CommitDTO selects the rows to turn into expenses and what they have in
+// common. Without lines every new row is committed. ExpenseType overrides
+// the type suggested for each row.
+type CommitDTO struct {
+	Lines       []int    `json:"lines"`
+	ExpenseType string   `json:"expense_type"`
+	CategoryID  string   `json:"category_id,omitempty"`
+	AccountID   string   `json:"account_id,omitempty"`
+	Currency    string   `json:"currency,omitempty"`
+	Tags        []string `json:"tags"`
+}
@@ -0,0 +1,240 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/google/uuid"
+)
+
+type ImportStatus string
+
+const (
+	ImportPending   ImportStatus = "pending"
+	ImportCommitted ImportStatus = "committed"
+)
+
+type RowStatus string
+
+const (
+	// RowNew is a row that matches no existing expense.
+	RowNew RowStatus = "new"
+	// RowDuplicate is a row that matches an existing expense. It is only
+	// committed when selected explicitly.
+	RowDuplicate RowStatus = "duplicate"
+	// RowInvalid is a row that could not be parsed.
+	RowInvalid RowStatus = "invalid"
+	// RowSkipped is a row that is not an expense, such as a credit.
+	RowSkipped RowStatus = "skipped"
+	// RowImported is a row that was committed as an expense.
+	RowImported RowStatus = "imported"
+)
+
+// Committable reports whether a row with this status can become an expense.
+func (s RowStatus) Committable() bool {
+	return s == RowNew || s == RowDuplicate
+}
+
+// Row is one transaction of a statement. Amount is positive for an expense.
+// Line is the line of a CSV file, or the position of the transaction in
+// other formats.
+//
+// ExternalID, Currency and ExpenseType are only known for statements that
+// carry them: ExternalID is the bank's reference for the transaction,
+// scoped to the account it was booked on, and ExpenseType is suggested by
+// the bank's transaction type.
+type Row struct {
+	Line        int
+	Date        time.Time
+	Amount      int64
+	Description string
+	Hash        string
+	ExternalID  string
+	Currency    string
+	ExpenseType expenseEntity.ExpenseType
+	Status      RowStatus
+	Error       string
+	ExpenseID   string
+}
+
+// NewRow builds a parsed row with its dedup hash.
+func NewRow(line int, date time.Time, amount int64, description string) Row {
+	description = strings.Join(strings.Fields(description), " ")
+	return Row{
+		Line:        line,
+		Date:        date,
+		Amount:      amount,
+		Description: description,
+		Hash:        DedupHash(date, amount, description),
+		Status:      RowNew,
+	}
+}
+
+// InvalidRow records a line that could not be parsed.
+func InvalidRow(line int, reason string) Row {
+	return Row{Line: line, Status: RowInvalid, Error: reason}
+}
+
+// DedupHash identifies an expense by its date, amount and description,
+// ignoring case and runs of whitespace in the description.
+func DedupHash(date time.Time, amount int64, description string) string {
+	normalized := strings.ToLower(strings.Join(strings.Fields(description), " "))
+	sum := sha256.Sum256([]byte(date.Format("2006-01-02") + "|" + strconv.FormatInt(amount, 10) + "|" + normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r Row) ToDTO() dto.ImportRowDTO {
+	result := dto.ImportRowDTO{
+		Line:        r.Line,
+		Amount:      money.Amount(r.Amount),
+		Description: r.Description,
+		Hash:        r.Hash,
+		ExternalID:  r.ExternalID,
+		Currency:    r.Currency,
+		ExpenseType: string(r.ExpenseType),
+		Status:      string(r.Status),
+		Error:       r.Error,
+		ExpenseID:   r.ExpenseID,
+	}
+
+	if !r.Date.IsZero() {
+		result.Date = r.Date.Format("2006-01-02")
+	}
+
+	return result
+}
+
+// Import is a statement parsed into rows, waiting to be committed.
+type Import struct {
+	id          string
+	userID      int64
+	source      string
+	filename    string
+	status      ImportStatus
+	createdAt   time.Time
+	committedAt *time.Time
+	rows        []Row
+}
+
+func NewImport(userID int64, source, filename string, rows []Row, now time.Time) (*Import, error) {
+	if userID <= 0 {
+		return nil, errors.New("import must belong to a user")
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.New("the file has no rows")
+	}
+
+	return &Import{
+		id:        uuid.New().String(),
+		userID:    userID,
+		source:    source,
+		filename:  filename,
+		status:    ImportPending,
+		createdAt: now.UTC().Truncate(time.Second),
+		rows:      rows,
+	}, nil
+}
+
+// RestoreImport rebuilds an import from persisted data.
+func RestoreImport(id string, userID int64, source, filename string, status ImportStatus, createdAt time.Time, committedAt *time.Time, rows []Row) *Import {
+	return &Import{
+		id:          id,
+		userID:      userID,
+		source:      source,
+		filename:    filename,
+		status:      status,
+		createdAt:   createdAt,
+		committedAt: committedAt,
+		rows:        rows,
+	}
+}
+
+// MarkCommitted records the expense created for each committed line.
+func (i *Import) MarkCommitted(expenseIDs map[int]string, now time.Time) {
+	for n := range i.rows {
+		if id, ok := expenseIDs[i.rows[n].Line]; ok {
+			i.rows[n].Status = RowImported
+			i.rows[n].ExpenseID = id
+		}
+	}
+
+	at := now.UTC().Truncate(time.Second)
+	i.status = ImportCommitted
+	i.committedAt = &at
+}
+
+func (i *Import) ToDTO() *dto.ImportDTO {
+	result := &dto.ImportDTO{
+		ID:        i.id,
+		Source:    i.source,
+		Filename:  i.filename,
+		Status:    string(i.status),
+		CreatedAt: i.createdAt.Format(time.RFC3339),
+		Rows:      make([]dto.ImportRowDTO, 0, len(i.rows)),
+	}
+
+	if i.committedAt != nil {
+		result.CommittedAt = i.committedAt.Format(time.RFC3339)
+	}
+
+	for _, row := range i.rows {
+		result.Rows = append(result.Rows, row.ToDTO())
+
+		result.Summary.Rows++
+		switch row.Status {
+		case RowNew:
+			result.Summary.New++
+		case RowDuplicate:
+			result.Summary.Duplicates++
+		case RowInvalid:
+			result.Summary.Invalid++
+		case RowSkipped:
+			result.Summary.Skipped++
+		case RowImported:
+			result.Summary.Imported++
+		}
+	}
+
+	return result
+}
+
+func (i *Import) ID() string {
+	return i.id
+}
+
+func (i *Import) UserID() int64 {
+	return i.userID
+}
+
+func (i *Import) Source() string {
+	return i.source
+}
+
+func (i *Import) Filename() string {
+	return i.filename
+}
+
+func (i *Import) Status() ImportStatus {
+	return i.status
+}
+
+func (i *Import) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+func (i *Import) CommittedAt() *time.Time {
+	return i.committedAt
+}
+
+// Rows returns the rows by reference; changes to them are kept.
+func (i *Import) Rows() []Row {
+	return i.rows
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+)
+
+// camtDocument maps the parts of a camt.053 statement that become rows.
+// Element names are matched without their namespace, so every version of
+// the message is read alike.
+type camtDocument struct {
+	Statements []struct {
+		IBAN    string      `xml:"Acct>Id>IBAN"`
+		Other   string      `xml:"Acct>Id>Othr>Id"`
+		Entries []camtEntry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtEntry struct {
+	Reference string `xml:"NtryRef"`
+	Amount    struct {
+		Value    string `xml:",chardata"`
+		Currency string `xml:"Ccy,attr"`
+	} `xml:"Amt"`
+	Indicator string `xml:"CdtDbtInd"`
+	Reversal  bool   `xml:"RvslInd"`
+	// Status is a code in versions before 2019 and a Cd element since.
+	Status struct {
+		Value string `xml:",chardata"`
+		Code  string `xml:"Cd"`
+	} `xml:"Sts"`
+	BookingDate       camtDate `xml:"BookgDt"`
+	ValueDate         camtDate `xml:"ValDt"`
+	ServicerReference string   `xml:"AcctSvcrRef"`
+	Family            string   `xml:"BkTxCd>Domn>Fmly>Cd"`
+	SubFamily         string   `xml:"BkTxCd>Domn>Fmly>SubFmlyCd"`
+	Transactions      []struct {
+		ServicerReference string   `xml:"Refs>AcctSvcrRef"`
+		Creditor          string   `xml:"RltdPties>Cdtr>Nm"`
+		CreditorParty     string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+		Remittance        []string `xml:"RmtInf>Ustrd"`
+	} `xml:"NtryDtls>TxDtls"`
+	Information string `xml:"AddtlNtryInf"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+func (d camtDate) String() string {
+	if d.Date != "" {
+		return d.Date
+	}
+	return d.DateTime
+}
+
+// parseCAMT053 reads the entries of every statement in a camt.053 file.
+// An entry booked as a batch becomes one row for its total.
+func parseCAMT053(content []byte) ([]entity.Row, error) {
+	var document camtDocument
+	if err := xml.NewDecoder(bytes.NewReader(content)).Decode(&document); err != nil {
+		return nil, fmt.Errorf("malformed XML: %w", err)
+	}
+
+	if len(document.Statements) == 0 {
+		return nil, errors.New("not a camt.053 statement")
+	}
+
+	rows := make([]entity.Row, 0)
+
+	for _, statement := range document.Statements {
+		account := statement.IBAN
+		if account == "" {
+			account = statement.Other
+		}
+
+		for _, entry := range statement.Entries {
+			if len(rows) == MaxImportRows {
+				return nil, fmt.Errorf("the file has more than %d entries", MaxImportRows)
+			}
+			rows = append(rows, camtRow(len(rows)+1, account, entry))
+		}
+	}
+
+	return rows, nil
+}
+
+func camtRow(line int, account string, entry camtEntry) entity.Row {
+	amount, err := parseStatementAmount(entry.Amount.Value)
+	if err != nil {
+		return entity.InvalidRow(line, fmt.Sprintf("invalid amount %q", entry.Amount.Value))
+	}
+
+	switch strings.TrimSpace(entry.Indicator) {
+	case "DBIT":
+	case "CRDT":
+		amount = -amount
+	default:
+		return entity.InvalidRow(line, fmt.Sprintf("invalid credit or debit indicator %q", entry.Indicator))
+	}
+
+	date := entry.BookingDate.String()
+	if date == "" {
+		date = entry.ValueDate.String()
+	}
+
+	reference := entry.ServicerReference
+	var payee, remittance string
+	if len(entry.Transactions) > 0 {
+		tx := entry.Transactions[0]
+		if reference == "" {
+			reference = tx.ServicerReference
+		}
+		payee = tx.Creditor
+		if payee == "" {
+			payee = tx.CreditorParty
+		}
+		remittance = strings.Join(tx.Remittance, " ")
+	}
+	if reference == "" {
+		reference = entry.Reference
+	}
+
+	description := joinDescription(payee, remittance)
+	if description == "" {
+		description = entry.Information
+	}
+
+	row := statementRow(
+		line,
+		date,
+		amount,
+		description,
+		statementExternalID(StatementCAMT053, account, reference),
+		entry.Amount.Currency,
+	)
+	row.ExpenseType = camtExpenseType(entry.Family, entry.SubFamily)
+
+	if !row.Status.Committable() {
+		return row
+	}
+
+	status := strings.TrimSpace(entry.Status.Code)
+	if status == "" {
+		status = strings.TrimSpace(entry.Status.Value)
+	}
+
+	switch {
+	case status != "" && status != "BOOK":
+		row.Status = entity.RowSkipped
+		row.Error = "not booked"
+	case entry.Reversal:
+		row.Status = entity.RowSkipped
+		row.Error = "reversal of a credit"
+	}
+
+	return row
+}
+
+// camtExpenseType suggests the type of an expense from the family and
+// sub-family of its ISO 20022 bank transaction code.
+func camtExpenseType(family, subFamily string) expenseEntity.ExpenseType {
+	switch {
+	case family == "RDDT", subFamily == "STDO":
+		// Direct debits and standing orders.
+		return expenseEntity.FixedExpense
+	case subFamily == "CHRG", subFamily == "FEES", subFamily == "COMM", subFamily == "INTR":
+		return expenseEntity.UnplannedExpense
+	default:
+		return expenseEntity.VariableExpense
+	}
+}
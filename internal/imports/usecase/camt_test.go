@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCAMT053(t *testing.T) {
+	file := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <GrpHdr><MsgId>MSG1</MsgId><CreDtTm>2026-04-10T08:00:00</CreDtTm></GrpHdr>
+    <Stmt>
+      <Id>STMT1</Id>
+      <Acct><Id><IBAN>DE89 3704 0044 0532 0130 00</IBAN></Id><Ccy>EUR</Ccy></Acct>
+      <Ntry>
+        <NtryRef>1</NtryRef>
+        <Amt Ccy="EUR">89.90</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2026-04-02</Dt></BookgDt>
+        <ValDt><Dt>2026-04-03</Dt></ValDt>
+        <AcctSvcrRef>REF-001</AcctSvcrRef>
+        <BkTxCd><Domn><Cd>PMNT</Cd><Fmly><Cd>RDDT</Cd><SubFmlyCd>ESDD</SubFmlyCd></Fmly></Domn></BkTxCd>
+        <NtryDtls><TxDtls>
+          <RltdPties><Cdtr><Pty><Nm>Stadtwerke</Nm></Pty></Cdtr></RltdPties>
+          <RmtInf><Ustrd>Strom April</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <NtryRef>2</NtryRef>
+        <Amt Ccy="EUR">2500.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2026-04-03</Dt></BookgDt>
+      </Ntry>
+      <Ntry>
+        <NtryRef>3</NtryRef>
+        <Amt Ccy="EUR">12.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><DtTm>2026-04-04T10:00:00+02:00</DtTm></BookgDt>
+      </Ntry>
+      <Ntry>
+        <NtryRef>4</NtryRef>
+        <Amt Ccy="EUR">4.50</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2026-04-05</Dt></BookgDt>
+        <BkTxCd><Domn><Cd>ACMT</Cd><Fmly><Cd>MDOP</Cd><SubFmlyCd>CHRG</SubFmlyCd></Fmly></Domn></BkTxCd>
+        <AddtlNtryInf>Kontofuehrung</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">ten</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+	rows, err := parseCAMT053([]byte(file))
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+
+	expected := entity.NewRow(1, time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), 8990, "Stadtwerke - Strom April")
+	expected.ExternalID = "camt053:DE89370400440532013000:REF-001"
+	expected.Currency = "EUR"
+	expected.ExpenseType = expenseEntity.FixedExpense
+	assert.Equal(t, expected, rows[0])
+
+	assert.Equal(t, entity.RowSkipped, rows[1].Status, "Credits are not expenses")
+	assert.Equal(t, "camt053:DE89370400440532013000:2", rows[1].ExternalID, "Falls back to the entry reference")
+
+	assert.Equal(t, entity.RowSkipped, rows[2].Status)
+	assert.Equal(t, "not booked", rows[2].Error)
+	assert.Equal(t, time.Date(2026, 4, 4, 0, 0, 0, 0, time.UTC), rows[2].Date)
+
+	assert.Equal(t, "Kontofuehrung", rows[3].Description)
+	assert.Equal(t, expenseEntity.UnplannedExpense, rows[3].ExpenseType)
+	assert.Equal(t, entity.RowNew, rows[3].Status)
+
+	assert.Equal(t, entity.RowInvalid, rows[4].Status)
+
+	t.Run("Not a statement", func(t *testing.T) {
+		_, err := parseCAMT053([]byte(`<Document><BkToCstmrDbtCdtNtfctn/></Document>`))
+		assert.Error(t, err)
+
+		_, err = parseCAMT053([]byte("not xml"))
+		assert.Error(t, err)
+	})
+}
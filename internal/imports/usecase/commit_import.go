@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	accounts "github.com/MarioGN/finance-manager-api/internal/accounts/usecase"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	notifications "github.com/MarioGN/finance-manager-api/internal/notifications/usecase"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/validation"
+)
+
+var ErrInvalidCommit = appErrors.Validation("invalid commit")
+
+type CommitImportUseCase struct {
+	store data.Store
+}
+
+func NewCommitImportUseCase(store data.Store) *CommitImportUseCase {
+	return &CommitImportUseCase{store: store}
+}
+
+// Execute creates an expense for each selected row, all in one transaction.
+// Without explicit lines, duplicates are checked again and every row that is
+// still new is committed.
+func (uc *CommitImportUseCase) Execute(userID int64, id string, input dto.CommitDTO) (result *dto.ImportDTO, err error) {
+	imp, err := uc.store.Imports.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import by ID: %w", err)
+	}
+
+	if imp.Status() != entity.ImportPending {
+		return nil, fmt.Errorf("%w: %s", data.ErrImportCommitted, id)
+	}
+
+	var v validation.Violations
+
+	expenseType := expenseEntity.ExpenseType(input.ExpenseType)
+	if input.ExpenseType != "" && !expenseType.IsValid() {
+		v.Add("/expense_type", expenseEntity.ErrInvalidExpenseType)
+	}
+	expenseEntity.ValidateTags(&v, input.Tags)
+
+	if err := v.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCommit, err)
+	}
+
+	currency, explicit, err := uc.commitCurrency(userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := imp.Rows()
+
+	selected, err := selectRows(uc.store, userID, rows, input.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses := make([]expenseEntity.Expense, 0, len(selected))
+	expenseIDs := make(map[int]string, len(selected))
+	dates := make([]time.Time, 0, len(selected))
+
+	for _, row := range selected {
+		rowType := expenseType
+		if rowType == "" {
+			rowType = row.ExpenseType
+		}
+		if rowType == "" {
+			rowType = expenseEntity.VariableExpense
+		}
+
+		rowCurrency := currency
+		if row.Currency != "" {
+			if explicit && row.Currency != currency {
+				return nil, fmt.Errorf("%w: line %d is in %s, not %s", ErrInvalidCommit, row.Line, row.Currency, currency)
+			}
+			rowCurrency = row.Currency
+		}
+
+		expense, err := expenseEntity.NewExpense(userID, row.Amount, row.Description, row.Date, rowType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidCommit, row.Line, err)
+		}
+
+		expense.SetCategoryID(input.CategoryID)
+		expense.SetAccountID(input.AccountID)
+		expense.SetExternalID(row.ExternalID)
+
+		if err := expense.SetCurrency(rowCurrency); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidCommit, row.Line, err)
+		}
+
+		if err := expense.SetTags(input.Tags); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCommit, err)
+		}
+
+		expenses = append(expenses, *expense)
+		expenseIDs[row.Line] = expense.ID()
+		dates = append(dates, row.Date)
+	}
+
+	imp.MarkCommitted(expenseIDs, now())
+
+	if err := uc.store.Imports.Commit(*imp, expenses); err != nil {
+		return nil, fmt.Errorf("failed to commit import: %w", err)
+	}
+
+	if err := notifications.NewCheckBudgetsUseCase(uc.store).Execute(userID, dates...); err != nil {
+		log.Print("Failed to check budgets: ", err)
+	}
+
+	return imp.ToDTO(), nil
+}
+
+// selectRows returns the rows to commit: the given lines, or every new row
+// once duplicates are checked again.
+func selectRows(store data.Store, userID int64, rows []entity.Row, lines []int) ([]entity.Row, error) {
+	selected := make([]entity.Row, 0)
+
+	if len(lines) == 0 {
+		if err := markDuplicates(store, userID, rows); err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			if row.Status == entity.RowNew {
+				selected = append(selected, row)
+			}
+		}
+
+		if len(selected) == 0 {
+			return nil, fmt.Errorf("%w: there are no new rows to commit", ErrInvalidCommit)
+		}
+
+		return selected, nil
+	}
+
+	byLine := make(map[int]entity.Row, len(rows))
+	for _, row := range rows {
+		byLine[row.Line] = row
+	}
+
+	seen := make(map[int]bool, len(lines))
+	for _, line := range lines {
+		row, ok := byLine[line]
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d is not in the import", ErrInvalidCommit, line)
+		}
+		if !row.Status.Committable() {
+			return nil, fmt.Errorf("%w: line %d is %s", ErrInvalidCommit, line, row.Status)
+		}
+		if seen[line] {
+			continue
+		}
+		seen[line] = true
+		selected = append(selected, row)
+	}
+
+	return selected, nil
+}
+
+// commitCurrency checks the category and account of the commit and picks
+// the currency of the expenses with accounts.ResolveCurrency: the account's,
+// the requested one or the user's base currency. It reports whether the currency was set by the
+// account or the request, in which case rows in another currency cannot be
+// committed; otherwise a row's own currency wins.
+func (uc *CommitImportUseCase) commitCurrency(userID int64, input dto.CommitDTO) (string, bool, error) {
+	if input.CategoryID != "" {
+		_, err := uc.store.Categories.FindByID(userID, input.CategoryID)
+		if errors.Is(err, data.ErrCategoryNotFound) {
+			return "", false, fmt.Errorf("%w: category %s does not exist", ErrInvalidCommit, input.CategoryID)
+		}
+		if err != nil {
+			return "", false, fmt.Errorf("failed to find category: %w", err)
+		}
+	}
+
+	currency, err := accounts.ResolveCurrency(uc.store, userID, input.AccountID, input.Currency, "")
+	switch {
+	case errors.Is(err, data.ErrAccountNotFound):
+		return "", false, fmt.Errorf("%w: account %s does not exist", ErrInvalidCommit, input.AccountID)
+	case errors.Is(err, accounts.ErrInvalidCurrency):
+		return "", false, fmt.Errorf("%w: %w", ErrInvalidCommit, err)
+	case err != nil:
+		return "", false, err
+	}
+
+	return currency, input.AccountID != "" || input.Currency != "", nil
+}
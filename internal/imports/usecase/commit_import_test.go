@@ -0,0 +1,309 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	authEntity "github.com/MarioGN/finance-manager-api/internal/auth/entity"
+	"github.com/MarioGN/finance-manager-api/internal/auth/repository"
+	budgetEntity "github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockImportRepository implements data.ImportRepository in memory for
+// testing
+type MockImportRepository struct {
+	data.ImportRepository
+
+	imports   map[string]entity.Import
+	committed []expenseEntity.Expense
+}
+
+func (m *MockImportRepository) FindByID(userID int64, id string) (*entity.Import, error) {
+	imp, ok := m.imports[id]
+	if !ok || imp.UserID() != userID {
+		return nil, fmt.Errorf("%w: %s", data.ErrImportNotFound, id)
+	}
+	rows := append([]entity.Row(nil), imp.Rows()...)
+	return entity.RestoreImport(imp.ID(), imp.UserID(), imp.Source(), imp.Filename(), imp.Status(), imp.CreatedAt(), imp.CommittedAt(), rows), nil
+}
+
+func (m *MockImportRepository) Save(imp entity.Import) error {
+	m.imports[imp.ID()] = imp
+	return nil
+}
+
+func (m *MockImportRepository) Commit(imp entity.Import, expenses []expenseEntity.Expense) error {
+	m.imports[imp.ID()] = imp
+	m.committed = append(m.committed, expenses...)
+	return nil
+}
+
+// MockExpenseRepository holds the expenses recorded before the import
+type MockExpenseRepository struct {
+	data.ExpenseRepository
+
+	expenses []expenseEntity.Expense
+}
+
+func (m *MockExpenseRepository) FindAll(filter data.ExpenseFilter) ([]expenseEntity.Expense, error) {
+	return m.expenses, nil
+}
+
+func (m *MockExpenseRepository) FindExternalIDs(userID int64, externalIDs []string) (map[string]string, error) {
+	found := make(map[string]string)
+	for _, e := range m.expenses {
+		for _, id := range externalIDs {
+			if e.ExternalID() == id {
+				found[id] = e.ID()
+			}
+		}
+	}
+	return found, nil
+}
+
+// MockBudgetRepository has no budgets, so committing raises no alerts
+type MockBudgetRepository struct {
+	data.BudgetRepository
+}
+
+func (m *MockBudgetRepository) FindAll(userID int64) ([]budgetEntity.Budget, error) {
+	return []budgetEntity.Budget{}, nil
+}
+
+type MockUserRepository struct {
+	repository.UserRepository
+}
+
+func (m *MockUserRepository) FindByID(id int64) (*authEntity.UserAccount, error) {
+	user := authEntity.RestoreUserAccount(id, "user@example.com", "")
+	if err := user.SetBaseCurrency("CHF"); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+const statement = `Date,Description,Amount
+2026-04-02,Coffee Shop,-3.20
+2026-04-02,coffee  shop,-3.20
+2026-04-03,Groceries,-45.10
+2026-04-04,Salary,2500.00
+2026-04-05,Cinema,oops
+`
+
+func newImportStore(t *testing.T, existing ...string) (data.Store, *MockImportRepository, *MockExpenseRepository) {
+	t.Helper()
+
+	expenses := &MockExpenseRepository{}
+	for _, description := range existing {
+		expense, err := expenseEntity.NewExpense(1, 320, description, time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), expenseEntity.VariableExpense)
+		require.NoError(t, err)
+		expenses.expenses = append(expenses.expenses, *expense)
+	}
+
+	imports := &MockImportRepository{imports: map[string]entity.Import{}}
+	store := data.Store{Imports: imports, Expenses: expenses, Budgets: &MockBudgetRepository{}, Users: &MockUserRepository{}}
+
+	return store, imports, expenses
+}
+
+func previewStatement(t *testing.T, store data.Store) *dto.ImportDTO {
+	t.Helper()
+
+	preview, err := NewPreviewCSVUseCase(store).Execute(1, "april.csv", strings.NewReader(statement), dto.CSVMappingDTO{
+		DateColumn:        "Date",
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Description",
+	})
+	require.NoError(t, err)
+
+	return preview
+}
+
+func rowStatuses(result *dto.ImportDTO) []string {
+	statuses := make([]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		statuses = append(statuses, row.Status)
+	}
+	return statuses
+}
+
+func TestPreviewCSV_Duplicates(t *testing.T) {
+	store, _, _ := newImportStore(t, "COFFEE SHOP")
+
+	preview := previewStatement(t, store)
+
+	assert.Equal(t, "pending", preview.Status)
+	assert.Equal(t, []string{"duplicate", "new", "new", "skipped", "invalid"}, rowStatuses(preview),
+		"One recorded expense should only match one of two identical rows")
+	assert.Equal(t, dto.ImportSummaryDTO{Rows: 5, New: 2, Duplicates: 1, Invalid: 1, Skipped: 1}, preview.Summary)
+	assert.Equal(t, preview.Rows[0].Hash, preview.Rows[1].Hash)
+}
+
+func TestPreviewCSV_Validation(t *testing.T) {
+	store, _, _ := newImportStore(t)
+
+	content := "Date,Amount,Description\n" +
+		"2026-04-02,-3.20,Coffee\n" +
+		"2099-04-02,-3.20," + strings.Repeat("x", expenseEntity.MaxDescriptionLength+1) + "\n"
+
+	preview, err := NewPreviewCSVUseCase(store).Execute(1, "april.csv", strings.NewReader(content), dto.CSVMappingDTO{
+		DateColumn:        "Date",
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Description",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"new", "invalid"}, rowStatuses(preview))
+	assert.Equal(t, expenseEntity.ErrDescriptionTooLong.Error()+"; "+expenseEntity.ErrDateTooFarAhead.Error(), preview.Rows[1].Error,
+		"Every violation of the row should be reported")
+}
+
+func TestCommitImport(t *testing.T) {
+	t.Run("New rows by default", func(t *testing.T) {
+		store, imports, expenses := newImportStore(t)
+		preview := previewStatement(t, store)
+
+		// An expense recorded between the preview and the commit.
+		recorded, err := expenseEntity.NewExpense(1, 4510, "Groceries", time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC), expenseEntity.VariableExpense)
+		require.NoError(t, err)
+		expenses.expenses = append(expenses.expenses, *recorded)
+
+		result, err := NewCommitImportUseCase(store).Execute(1, preview.ID, dto.CommitDTO{Tags: []string{"import"}})
+		require.NoError(t, err)
+
+		assert.Equal(t, "committed", result.Status)
+		assert.Equal(t, []string{"imported", "imported", "duplicate", "skipped", "invalid"}, rowStatuses(result))
+
+		require.Len(t, imports.committed, 2)
+		for i, expense := range imports.committed {
+			assert.Equal(t, result.Rows[i].ExpenseID, expense.ID())
+			assert.Equal(t, int64(320), expense.Amount())
+			assert.Equal(t, "CHF", expense.Currency(), "The base currency should be the default")
+			assert.Equal(t, expenseEntity.VariableExpense, expense.ExpenseType())
+			assert.Equal(t, []string{"import"}, expense.Tags())
+		}
+
+		_, err = NewCommitImportUseCase(store).Execute(1, preview.ID, dto.CommitDTO{})
+		assert.ErrorIs(t, err, data.ErrImportCommitted)
+	})
+
+	t.Run("Selected lines", func(t *testing.T) {
+		store, imports, _ := newImportStore(t, "Coffee Shop")
+		preview := previewStatement(t, store)
+
+		result, err := NewCommitImportUseCase(store).Execute(1, preview.ID, dto.CommitDTO{Lines: []int{2, 4, 2}, ExpenseType: "fixed"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"imported", "new", "imported", "skipped", "invalid"}, rowStatuses(result),
+			"Selected duplicates should be committed")
+		require.Len(t, imports.committed, 2)
+		assert.Equal(t, expenseEntity.FixedExpense, imports.committed[0].ExpenseType())
+	})
+
+	t.Run("Invalid selection", func(t *testing.T) {
+		for _, input := range []dto.CommitDTO{
+			{Lines: []int{5}},
+			{Lines: []int{6}},
+			{Lines: []int{99}},
+			{ExpenseType: "monthly"},
+			{Currency: "euro"},
+		} {
+			store, imports, _ := newImportStore(t)
+			preview := previewStatement(t, store)
+
+			_, err := NewCommitImportUseCase(store).Execute(1, preview.ID, input)
+			assert.ErrorIs(t, err, ErrInvalidCommit, "%+v", input)
+			assert.Empty(t, imports.committed)
+		}
+	})
+
+	t.Run("Nothing new", func(t *testing.T) {
+		store, _, expenses := newImportStore(t, "Coffee Shop", "Coffee Shop")
+		preview := previewStatement(t, store)
+
+		groceries, err := expenseEntity.NewExpense(1, 4510, "groceries", time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC), expenseEntity.VariableExpense)
+		require.NoError(t, err)
+		expenses.expenses = append(expenses.expenses, *groceries)
+
+		_, err = NewCommitImportUseCase(store).Execute(1, preview.ID, dto.CommitDTO{})
+		assert.ErrorIs(t, err, ErrInvalidCommit)
+	})
+
+	t.Run("Unknown import", func(t *testing.T) {
+		store, _, _ := newImportStore(t)
+
+		_, err := NewCommitImportUseCase(store).Execute(1, "missing", dto.CommitDTO{})
+		assert.ErrorIs(t, err, data.ErrImportNotFound)
+	})
+}
+
+const ofxStatement = `<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><CURDEF>EUR
+<BANKACCTFROM><ACCTID>42</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>DIRECTDEBIT<DTPOSTED>20260401<TRNAMT>-39.99<FITID>T1<NAME>Gym</STMTTRN>
+<STMTTRN><TRNTYPE>POS<DTPOSTED>20260402<TRNAMT>-3.20<FITID>T2<NAME>Coffee Shop</STMTTRN>
+<STMTTRN><TRNTYPE>POS<DTPOSTED>20260402<TRNAMT>-3.20<FITID>T2<NAME>Coffee Shop</STMTTRN>
+</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>`
+
+func TestCommitStatement(t *testing.T) {
+	preview := func(t *testing.T, store data.Store) *dto.ImportDTO {
+		t.Helper()
+
+		result, err := NewPreviewStatementUseCase(store).Execute(1, StatementOFX, "april.ofx", strings.NewReader(ofxStatement))
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("Importing twice adds nothing", func(t *testing.T) {
+		store, imports, expenses := newImportStore(t)
+
+		first := preview(t, store)
+		assert.Equal(t, []string{"new", "new", "skipped"}, rowStatuses(first), "A repeated FITID is the same transaction")
+
+		_, err := NewCommitImportUseCase(store).Execute(1, first.ID, dto.CommitDTO{})
+		require.NoError(t, err)
+
+		require.Len(t, imports.committed, 2)
+		assert.Equal(t, "ofx:42:T1", imports.committed[0].ExternalID())
+		assert.Equal(t, "EUR", imports.committed[0].Currency(), "The statement currency should win over the base currency")
+		assert.Equal(t, expenseEntity.FixedExpense, imports.committed[0].ExpenseType())
+		assert.Equal(t, expenseEntity.VariableExpense, imports.committed[1].ExpenseType())
+
+		expenses.expenses = append(expenses.expenses, imports.committed...)
+
+		second := preview(t, store)
+		assert.Equal(t, []string{"skipped", "skipped", "skipped"}, rowStatuses(second))
+		assert.Equal(t, "already imported as expense "+imports.committed[0].ID(), second.Rows[0].Error)
+
+		_, err = NewCommitImportUseCase(store).Execute(1, second.ID, dto.CommitDTO{})
+		assert.ErrorIs(t, err, ErrInvalidCommit)
+	})
+
+	t.Run("Expense type and currency of the commit", func(t *testing.T) {
+		store, imports, _ := newImportStore(t)
+
+		_, err := NewCommitImportUseCase(store).Execute(1, preview(t, store).ID, dto.CommitDTO{Currency: "USD"})
+		assert.ErrorIs(t, err, ErrInvalidCommit, "Rows in EUR cannot be committed in USD")
+
+		_, err = NewCommitImportUseCase(store).Execute(1, preview(t, store).ID, dto.CommitDTO{ExpenseType: "monthly", Tags: []string{"ok", ""}})
+		assert.ErrorIs(t, err, ErrInvalidCommit)
+		assert.Equal(t, []appErrors.FieldError{
+			{Pointer: "/expense_type", Detail: expenseEntity.ErrInvalidExpenseType.Error()},
+			{Pointer: "/tags/1", Detail: `invalid tag "": tag name cannot be empty`},
+		}, appErrors.Fields(err))
+
+		_, err = NewCommitImportUseCase(store).Execute(1, preview(t, store).ID, dto.CommitDTO{Currency: "EUR", ExpenseType: "unplanned"})
+		require.NoError(t, err)
+		require.Len(t, imports.committed, 2)
+		assert.Equal(t, expenseEntity.UnplannedExpense, imports.committed[0].ExpenseType())
+	})
+}
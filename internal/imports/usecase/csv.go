@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/locale"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+const (
+	AmountSignNegative = "negative"
+	AmountSignPositive = "positive"
+)
+
+// csvMapping is a validated dto.CSVMappingDTO.
+type csvMapping struct {
+	delimiter         rune
+	header            bool
+	dateColumn        string
+	dateLayout        string
+	amountColumn      string
+	expensesNegative  bool
+	decimalSeparator  string
+	descriptionColumn string
+}
+
+func parseCSVMapping(input dto.CSVMappingDTO) (csvMapping, error) {
+	mapping := csvMapping{
+		delimiter:         ',',
+		header:            !input.NoHeader,
+		dateColumn:        strings.TrimSpace(input.DateColumn),
+		dateLayout:        "2006-01-02",
+		amountColumn:      strings.TrimSpace(input.AmountColumn),
+		expensesNegative:  true,
+		decimalSeparator:  ".",
+		descriptionColumn: strings.TrimSpace(input.DescriptionColumn),
+	}
+
+	switch input.Delimiter {
+	case "":
+	case "tab", `\t`:
+		mapping.delimiter = '\t'
+	default:
+		r, size := utf8.DecodeRuneInString(input.Delimiter)
+		if size != len(input.Delimiter) || r == '"' || r == '\r' || r == '\n' {
+			return mapping, errors.New("delimiter must be a single character")
+		}
+		mapping.delimiter = r
+	}
+
+	if mapping.dateColumn == "" || mapping.amountColumn == "" || mapping.descriptionColumn == "" {
+		return mapping, errors.New("date_column, amount_column and description_column are required")
+	}
+
+	if input.DateFormat != "" {
+		layout, err := locale.DateLayout(input.DateFormat)
+		if err != nil {
+			return mapping, err
+		}
+		mapping.dateLayout = layout
+	}
+
+	switch input.AmountSign {
+	case "", AmountSignNegative:
+	case AmountSignPositive:
+		mapping.expensesNegative = false
+	default:
+		return mapping, errors.New("amount_sign must be negative or positive")
+	}
+
+	switch input.DecimalSeparator {
+	case "", ".":
+	case ",":
+		mapping.decimalSeparator = ","
+	default:
+		return mapping, errors.New(`decimal_separator must be "." or ","`)
+	}
+
+	return mapping, nil
+}
+
+// parseCSV reads the statement into rows. Lines that cannot be parsed are
+// kept as invalid rows; an unreadable file is an error.
+func parseCSV(file io.Reader, mapping csvMapping) ([]entity.Row, error) {
+	reader := csv.NewReader(skipBOM(file))
+	reader.Comma = mapping.delimiter
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var header []string
+	if mapping.header {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("the file is empty")
+		}
+		if err != nil {
+			return nil, err
+		}
+		header = record
+	}
+
+	dateIndex, err := columnIndex(header, mapping.dateColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	amountIndex, err := columnIndex(header, mapping.amountColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptionIndex, err := columnIndex(header, mapping.descriptionColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]entity.Row, 0)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == MaxImportRows {
+			return nil, fmt.Errorf("the file has more than %d rows", MaxImportRows)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, parseRecord(line, record, dateIndex, amountIndex, descriptionIndex, mapping))
+	}
+
+	return rows, nil
+}
+
+func parseRecord(line int, record []string, dateIndex, amountIndex, descriptionIndex int, mapping csvMapping) entity.Row {
+	if len(record) <= max(dateIndex, amountIndex, descriptionIndex) {
+		return entity.InvalidRow(line, fmt.Sprintf("expected at least %d columns", max(dateIndex, amountIndex, descriptionIndex)+1))
+	}
+
+	date, err := time.Parse(mapping.dateLayout, strings.TrimSpace(record[dateIndex]))
+	if err != nil {
+		return entity.InvalidRow(line, fmt.Sprintf("invalid date %q", record[dateIndex]))
+	}
+
+	amount, err := parseAmount(record[amountIndex], mapping.decimalSeparator)
+	if err != nil {
+		return entity.InvalidRow(line, fmt.Sprintf("invalid amount %q", record[amountIndex]))
+	}
+
+	if mapping.expensesNegative {
+		amount = -amount
+	}
+
+	row := entity.NewRow(line, date, amount, record[descriptionIndex])
+	if amount <= 0 {
+		row.Status = entity.RowSkipped
+		row.Error = "not an expense"
+	}
+
+	return row
+}
+
+// columnIndex finds a column by header name, ignoring case, or by its
+// 1-based position.
+func columnIndex(header []string, column string) (int, error) {
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), column) {
+			return i, nil
+		}
+	}
+
+	position, err := strconv.Atoi(column)
+	if err != nil || position < 1 {
+		return 0, fmt.Errorf("column %q not found", column)
+	}
+
+	return position - 1, nil
+}
+
+// parseAmount parses an amount written with the given decimal separator.
+// The other separator is taken as a thousands separator and dropped, as are
+// spaces. An amount in parentheses is negative.
+func parseAmount(value, decimalSeparator string) (int64, error) {
+	s := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\u00a0' || r == '\'' {
+			return -1
+		}
+		return r
+	}, value)
+
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+
+	if decimalSeparator == "," {
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.ReplaceAll(s, ",", ".")
+	} else {
+		s = strings.ReplaceAll(s, ",", "")
+	}
+
+	amount, err := money.Parse(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	return int64(amount), nil
+}
+
+func skipBOM(file io.Reader) io.Reader {
+	reader := bufio.NewReader(file)
+	if bom, err := reader.Peek(3); err == nil && bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		reader.Discard(3)
+	}
+	return reader
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV(t *testing.T) {
+	t.Run("European statement", func(t *testing.T) {
+		mapping, err := parseCSVMapping(dto.CSVMappingDTO{
+			Delimiter:         ";",
+			DateColumn:        "Buchungstag",
+			DateFormat:        "DD.MM.YYYY",
+			AmountColumn:      "betrag",
+			DecimalSeparator:  ",",
+			DescriptionColumn: "Verwendungszweck",
+		})
+		require.NoError(t, err)
+
+		file := "\xef\xbb\xbfBuchungstag;Verwendungszweck;Betrag\n" +
+			"02.04.2026;Bäckerei   Müller;-1.234,50\n" +
+			"03.04.2026;Gehalt;2.500,00\n" +
+			"04.04.2026;Kaffee;(3,20)\n" +
+			"2026-04-05;Kino;-12,00\n" +
+			"06.04.2026;Miete;-zwölf\n" +
+			"07.04.2026;Kurz\n"
+
+		rows, err := parseCSV(strings.NewReader(file), mapping)
+		require.NoError(t, err)
+		require.Len(t, rows, 6)
+
+		assert.Equal(t, entity.NewRow(2, time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), 123450, "Bäckerei Müller"), rows[0])
+		assert.Equal(t, entity.RowSkipped, rows[1].Status, "Credits are not expenses")
+		assert.Equal(t, int64(320), rows[2].Amount, "Parentheses mean a negative amount")
+		assert.Equal(t, entity.RowNew, rows[2].Status)
+		assert.Equal(t, entity.RowInvalid, rows[3].Status)
+		assert.Contains(t, rows[3].Error, "invalid date")
+		assert.Equal(t, 5, rows[3].Line)
+		assert.Contains(t, rows[4].Error, "invalid amount")
+		assert.Contains(t, rows[5].Error, "columns")
+	})
+
+	t.Run("Positional columns with positive expenses", func(t *testing.T) {
+		mapping, err := parseCSVMapping(dto.CSVMappingDTO{
+			NoHeader:          true,
+			DateColumn:        "1",
+			DateFormat:        "MM/DD/YYYY",
+			AmountColumn:      "3",
+			AmountSign:        AmountSignPositive,
+			DescriptionColumn: "2",
+		})
+		require.NoError(t, err)
+
+		rows, err := parseCSV(strings.NewReader("04/02/2026,\"Books, used\",\"1,024.99\"\n04/03/2026,Refund,-5.00\n"), mapping)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		assert.Equal(t, 1, rows[0].Line)
+		assert.Equal(t, "Books, used", rows[0].Description)
+		assert.Equal(t, int64(102499), rows[0].Amount)
+		assert.Equal(t, entity.RowSkipped, rows[1].Status)
+	})
+
+	t.Run("Missing column", func(t *testing.T) {
+		mapping, err := parseCSVMapping(dto.CSVMappingDTO{DateColumn: "Date", AmountColumn: "Amount", DescriptionColumn: "Memo"})
+		require.NoError(t, err)
+
+		_, err = parseCSV(strings.NewReader("Date,Amount,Description\n2026-04-02,-1.00,x\n"), mapping)
+		assert.ErrorContains(t, err, `column "Memo" not found`)
+	})
+}
+
+func TestParseCSVMapping_Invalid(t *testing.T) {
+	valid := dto.CSVMappingDTO{DateColumn: "Date", AmountColumn: "Amount", DescriptionColumn: "Description"}
+
+	tests := []struct {
+		name   string
+		mutate func(*dto.CSVMappingDTO)
+	}{
+		{name: "Missing column", mutate: func(m *dto.CSVMappingDTO) { m.AmountColumn = "" }},
+		{name: "Long delimiter", mutate: func(m *dto.CSVMappingDTO) { m.Delimiter = ";;" }},
+		{name: "Unknown date format", mutate: func(m *dto.CSVMappingDTO) { m.DateFormat = "Month D, Year" }},
+		{name: "Date format without a day", mutate: func(m *dto.CSVMappingDTO) { m.DateFormat = "YYYY-MM" }},
+		{name: "Unknown amount sign", mutate: func(m *dto.CSVMappingDTO) { m.AmountSign = "debit" }},
+		{name: "Unknown decimal separator", mutate: func(m *dto.CSVMappingDTO) { m.DecimalSeparator = "'" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping := valid
+			tt.mutate(&mapping)
+
+			_, err := parseCSVMapping(mapping)
+			assert.Error(t, err)
+		})
+	}
+}
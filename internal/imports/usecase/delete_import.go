@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+)
+
+type DeleteImportUseCase struct {
+	store data.Store
+}
+
+func NewDeleteImportUseCase(store data.Store) *DeleteImportUseCase {
+	return &DeleteImportUseCase{store: store}
+}
+
+// Execute discards an import. Expenses already committed from it are kept.
+func (uc *DeleteImportUseCase) Execute(userID int64, id string) error {
+	if err := uc.store.Imports.Delete(userID, id); err != nil {
+		return fmt.Errorf("failed to delete import: %w", err)
+	}
+
+	return nil
+}
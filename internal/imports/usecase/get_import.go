@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+)
+
+type GetImportUseCase struct {
+	store data.Store
+}
+
+func NewGetImportUseCase(store data.Store) *GetImportUseCase {
+	return &GetImportUseCase{store: store}
+}
+
+func (uc *GetImportUseCase) Execute(userID int64, id string) (result *dto.ImportDTO, err error) {
+	imp, err := uc.store.Imports.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import by ID: %w", err)
+	}
+
+	return imp.ToDTO(), nil
+}
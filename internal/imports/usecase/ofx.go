@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+)
+
+// ofxEntities are the character references allowed in OFX values.
+var ofxEntities = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&", "&quot;", `"`, "&apos;", "'", "&nbsp;", " ")
+
+// ofxTransaction holds the elements of one STMTTRN aggregate. Elements of a
+// nested aggregate are keyed by the aggregate and the element, such as
+// CURRENCY.CURSYM; direct children by the element name alone.
+type ofxTransaction struct {
+	fields   map[string]string
+	account  string
+	currency string
+}
+
+// parseOFX reads the transactions of an OFX or QFX statement. Both syntaxes
+// are read by the same tokenizer: in SGML, leaf elements have no end tag and
+// their value runs to the next tag; in XML, their end tags are ignored.
+func parseOFX(content []byte) ([]entity.Row, error) {
+	if !utf8.Valid(content) {
+		content = latin1ToUTF8(content)
+	}
+
+	text := string(content)
+	start := strings.Index(strings.ToUpper(text), "<OFX>")
+	if start < 0 {
+		return nil, errors.New("not an OFX file")
+	}
+	text = text[start:]
+
+	var (
+		transactions []ofxTransaction
+		current      *ofxTransaction
+		stack        []string
+		account      string
+		currency     string
+	)
+
+	for len(text) > 0 {
+		open := strings.IndexByte(text, '<')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(text[open:], '>')
+		if end < 0 {
+			return nil, errors.New("malformed OFX: unterminated tag")
+		}
+
+		tag := text[open+1 : open+end]
+		text = text[open+end+1:]
+
+		if tag == "" || tag[0] == '?' || tag[0] == '!' {
+			continue
+		}
+
+		if tag[0] == '/' {
+			name := strings.ToUpper(strings.TrimSpace(tag[1:]))
+			depth := len(stack) - 1
+			for depth >= 0 && stack[depth] != name {
+				depth--
+			}
+			if depth < 0 {
+				// The end tag of a leaf element in XML.
+				continue
+			}
+			// Empty SGML leaves were taken for aggregates; close them too.
+			stack = stack[:depth]
+
+			if name == "STMTTRN" && current != nil {
+				if len(transactions) == MaxImportRows {
+					return nil, fmt.Errorf("the file has more than %d transactions", MaxImportRows)
+				}
+				transactions = append(transactions, *current)
+				current = nil
+			}
+			continue
+		}
+
+		name := strings.ToUpper(strings.TrimSuffix(strings.TrimSpace(tag), "/"))
+
+		value := text
+		if next := strings.IndexByte(text, '<'); next >= 0 {
+			value = text[:next]
+		}
+		value = strings.TrimSpace(ofxEntities.Replace(value))
+
+		if value == "" {
+			if strings.HasSuffix(tag, "/") {
+				continue
+			}
+
+			stack = append(stack, name)
+			if name == "STMTTRN" {
+				current = &ofxTransaction{fields: make(map[string]string), account: account, currency: currency}
+			}
+			continue
+		}
+
+		parent := ""
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+
+		switch {
+		case current != nil && parent == "STMTTRN":
+			current.fields[name] = value
+		case current != nil:
+			current.fields[parent+"."+name] = value
+		case name == "CURDEF":
+			currency = value
+		case name == "ACCTID" && (parent == "BANKACCTFROM" || parent == "CCACCTFROM"):
+			account = value
+		}
+	}
+
+	rows := make([]entity.Row, 0, len(transactions))
+	for i, t := range transactions {
+		rows = append(rows, ofxRow(i+1, t))
+	}
+
+	return rows, nil
+}
+
+func ofxRow(line int, t ofxTransaction) entity.Row {
+	amount, err := parseStatementAmount(t.fields["TRNAMT"])
+	if err != nil {
+		return entity.InvalidRow(line, fmt.Sprintf("invalid amount %q", t.fields["TRNAMT"]))
+	}
+
+	// A transaction in another currency than the statement carries its own.
+	currency := t.currency
+	if symbol := t.fields["CURRENCY.CURSYM"]; symbol != "" {
+		currency = symbol
+	}
+
+	name := t.fields["NAME"]
+	if name == "" {
+		name = t.fields["PAYEE.NAME"]
+	}
+
+	row := statementRow(
+		line,
+		t.fields["DTPOSTED"],
+		-amount,
+		joinDescription(name, t.fields["MEMO"]),
+		statementExternalID(StatementOFX, t.account, t.fields["FITID"]),
+		currency,
+	)
+	row.ExpenseType = ofxExpenseType(t.fields["TRNTYPE"])
+
+	return row
+}
+
+// ofxExpenseType suggests the type of an expense from its OFX TRNTYPE.
+func ofxExpenseType(transactionType string) expenseEntity.ExpenseType {
+	switch strings.ToUpper(transactionType) {
+	case "REPEATPMT", "DIRECTDEBIT":
+		return expenseEntity.FixedExpense
+	case "FEE", "SRVCHG":
+		return expenseEntity.UnplannedExpense
+	default:
+		return expenseEntity.VariableExpense
+	}
+}
+
+// parseStatementDate reads the date of an OFX datetime, such as
+// 20260315120000.000[-5:EST], or of an ISO 8601 date or datetime.
+func parseStatementDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if len(value) >= 10 && value[4] == '-' {
+		return time.Parse("2006-01-02", value[:10])
+	}
+
+	if len(value) >= 8 {
+		return time.Parse("20060102", value[:8])
+	}
+
+	return time.Time{}, errors.New("invalid date")
+}
+
+// latin1ToUTF8 decodes OFX 1.x files sent in a single-byte charset, which
+// is close enough to Latin-1 for payee names.
+func latin1ToUTF8(content []byte) []byte {
+	var buf bytes.Buffer
+	buf.Grow(len(content) * 2)
+	for _, b := range content {
+		buf.WriteRune(rune(b))
+	}
+	return buf.Bytes()
+}
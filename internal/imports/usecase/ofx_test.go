@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOFX(t *testing.T) {
+	t.Run("SGML statement", func(t *testing.T) {
+		file := "OFXHEADER:100\r\nDATA:OFXSGML\r\nVERSION:102\r\nCHARSET:1252\r\n\r\n" +
+			"<OFX><SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>20260410</SONRS></SIGNONMSGSRSV1>\r\n" +
+			"<BANKMSGSRSV1><STMTTRNRS><TRNUID>1<STMTRS><CURDEF>USD\r\n" +
+			"<BANKACCTFROM><BANKID>121000248<ACCTID>0012 3456<ACCTTYPE>CHECKING</BANKACCTFROM>\r\n" +
+			"<BANKTRANLIST><DTSTART>20260401<DTEND>20260410\r\n" +
+			"<STMTTRN><TRNTYPE>POS<DTPOSTED>20260402120000.000[-5:EST]<TRNAMT>-12.50<FITID>A1<NAME>Caf\xe9 &amp; Bar<MEMO></STMTTRN>\r\n" +
+			"<STMTTRN><TRNTYPE>REPEATPMT<DTPOSTED>20260403<TRNAMT>-950,00<FITID>A2<NAME>Rent<MEMO>April</STMTTRN>\r\n" +
+			"<STMTTRN><TRNTYPE>SRVCHG<DTPOSTED>20260404<TRNAMT>-3.000<FITID>A3<NAME>Monthly fee" +
+			"<CURRENCY><CURRATE>1.08<CURSYM>EUR</CURRENCY></STMTTRN>\r\n" +
+			"<STMTTRN><TRNTYPE>DIRECTDEP<DTPOSTED>20260405<TRNAMT>2500.00<FITID>A4<NAME>Payroll</STMTTRN>\r\n" +
+			"<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>soon<TRNAMT>-1.00<FITID>A5</STMTTRN>\r\n" +
+			"</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>\r\n"
+
+		rows, err := parseOFX([]byte(file))
+		require.NoError(t, err)
+		require.Len(t, rows, 5)
+
+		expected := entity.NewRow(1, time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), 1250, "Café & Bar")
+		expected.ExternalID = "ofx:00123456:A1"
+		expected.Currency = "USD"
+		expected.ExpenseType = expenseEntity.VariableExpense
+		assert.Equal(t, expected, rows[0])
+
+		assert.Equal(t, "Rent - April", rows[1].Description)
+		assert.Equal(t, int64(95000), rows[1].Amount)
+		assert.Equal(t, expenseEntity.FixedExpense, rows[1].ExpenseType)
+
+		assert.Equal(t, int64(300), rows[2].Amount)
+		assert.Equal(t, "EUR", rows[2].Currency, "A transaction may be in its own currency")
+		assert.Equal(t, expenseEntity.UnplannedExpense, rows[2].ExpenseType)
+
+		assert.Equal(t, entity.RowSkipped, rows[3].Status, "Credits are not expenses")
+		assert.Equal(t, entity.RowInvalid, rows[4].Status)
+		assert.Contains(t, rows[4].Error, "invalid date")
+	})
+
+	t.Run("XML credit card statement", func(t *testing.T) {
+		file := `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+    <CURDEF>GBP</CURDEF>
+    <CCACCTFROM><ACCTID>4111</ACCTID></CCACCTFROM>
+    <BANKTRANLIST>
+      <STMTTRN>
+        <TRNTYPE>DEBIT</TRNTYPE>
+        <DTPOSTED>20260402</DTPOSTED>
+        <TRNAMT>-42.00</TRNAMT>
+        <FITID>X-1</FITID>
+        <PAYEE><NAME>Bookshop</NAME></PAYEE>
+        <MEMO/>
+      </STMTTRN>
+    </BANKTRANLIST>
+  </CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1>
+</OFX>`
+
+		rows, err := parseOFX([]byte(file))
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		assert.Equal(t, "Bookshop", rows[0].Description)
+		assert.Equal(t, int64(4200), rows[0].Amount)
+		assert.Equal(t, "GBP", rows[0].Currency)
+		assert.Equal(t, "ofx:4111:X-1", rows[0].ExternalID)
+		assert.Equal(t, entity.RowNew, rows[0].Status)
+	})
+
+	t.Run("Not an OFX file", func(t *testing.T) {
+		_, err := parseOFX([]byte("date,amount\n2026-04-02,1.00\n"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/validation"
+)
+
+const (
+	// MaxImportSize bounds the size of an uploaded statement in bytes.
+	MaxImportSize = 5 << 20
+	// MaxImportRows bounds the number of rows in a statement.
+	MaxImportRows = 5000
+)
+
+var ErrInvalidImport = appErrors.Validation("invalid import")
+
+var now = time.Now
+
+type PreviewCSVUseCase struct {
+	store data.Store
+}
+
+func NewPreviewCSVUseCase(store data.Store) *PreviewCSVUseCase {
+	return &PreviewCSVUseCase{store: store}
+}
+
+// Execute parses a CSV statement with mapping, flags the rows that duplicate
+// existing expenses and keeps the result as a pending import. Nothing is
+// added to the expenses until the import is committed.
+func (uc *PreviewCSVUseCase) Execute(userID int64, filename string, file io.Reader, input dto.CSVMappingDTO) (result *dto.ImportDTO, err error) {
+	mapping, err := parseCSVMapping(input)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(file, MaxImportSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(content) > MaxImportSize {
+		return nil, fmt.Errorf("%w: the file is larger than %d bytes", ErrInvalidImport, MaxImportSize)
+	}
+
+	rows, err := parseCSV(bytes.NewReader(content), mapping)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	return savePreview(uc.store, userID, "csv", filename, rows)
+}
+
+// savePreview flags invalid rows and duplicates among rows and stores them
+// as a pending import.
+func savePreview(store data.Store, userID int64, source, filename string, rows []entity.Row) (*dto.ImportDTO, error) {
+	validateRows(rows)
+
+	if err := markDuplicates(store, userID, rows); err != nil {
+		return nil, err
+	}
+
+	imp, err := entity.NewImport(userID, source, filename, rows, now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	if err := store.Imports.Save(*imp); err != nil {
+		return nil, fmt.Errorf("failed to save import: %w", err)
+	}
+
+	return imp.ToDTO(), nil
+}
+
+// validateRows marks invalid the committable rows that break the rules for a
+// new expense, with every violation in the row's error. A row without an
+// expense type is checked as the variable expense it becomes by default.
+func validateRows(rows []entity.Row) {
+	for i, row := range rows {
+		if !row.Status.Committable() {
+			continue
+		}
+
+		expenseType := row.ExpenseType
+		if expenseType == "" {
+			expenseType = expenseEntity.VariableExpense
+		}
+
+		var v validation.Violations
+		expenseEntity.Validate(&v, row.Amount, row.Description, row.Date, expenseType)
+		if err := v.Err(); err != nil {
+			rows[i].Status = entity.RowInvalid
+			rows[i].Error = err.Error()
+		}
+	}
+}
+
+// markDuplicates flags the committable rows that match an existing expense.
+// A row carrying the bank's reference is skipped when an expense was already
+// imported with it, or when an earlier row of the statement has it, so that
+// importing a statement again adds nothing. Other rows are matched by date,
+// amount and description; each expense matches one row at most, so a
+// statement with two identical purchases against one recorded expense keeps
+// one of them new.
+func markDuplicates(store data.Store, userID int64, rows []entity.Row) error {
+	if err := markImported(store, userID, rows); err != nil {
+		return err
+	}
+
+	var from, to time.Time
+	for _, row := range rows {
+		if !row.Status.Committable() {
+			continue
+		}
+		if from.IsZero() || row.Date.Before(from) {
+			from = row.Date
+		}
+		if to.IsZero() || row.Date.After(to) {
+			to = row.Date
+		}
+	}
+
+	if from.IsZero() {
+		return nil
+	}
+
+	expenses, err := store.Expenses.FindAll(data.ExpenseFilter{UserID: userID, From: &from, To: &to})
+	if err != nil {
+		return fmt.Errorf("failed to list expenses: %w", err)
+	}
+
+	existing := make(map[string]int, len(expenses))
+	for _, e := range expenses {
+		existing[entity.DedupHash(e.Date(), e.Amount(), e.Description())]++
+	}
+
+	for i := range rows {
+		if !rows[i].Status.Committable() {
+			continue
+		}
+
+		rows[i].Status = entity.RowNew
+		if existing[rows[i].Hash] > 0 {
+			existing[rows[i].Hash]--
+			rows[i].Status = entity.RowDuplicate
+		}
+	}
+
+	return nil
+}
+
+// markImported skips the committable rows whose external ID was already
+// imported or appears earlier in the statement.
+func markImported(store data.Store, userID int64, rows []entity.Row) error {
+	externalIDs := make([]string, 0)
+	for _, row := range rows {
+		if row.ExternalID != "" && row.Status.Committable() {
+			externalIDs = append(externalIDs, row.ExternalID)
+		}
+	}
+
+	if len(externalIDs) == 0 {
+		return nil
+	}
+
+	imported, err := store.Expenses.FindExternalIDs(userID, externalIDs)
+	if err != nil {
+		return fmt.Errorf("failed to find imported transactions: %w", err)
+	}
+
+	seen := make(map[string]int, len(externalIDs))
+	for i := range rows {
+		if rows[i].ExternalID == "" || !rows[i].Status.Committable() {
+			continue
+		}
+
+		if expenseID, ok := imported[rows[i].ExternalID]; ok {
+			rows[i].Status = entity.RowSkipped
+			rows[i].Error = fmt.Sprintf("already imported as expense %s", expenseID)
+			continue
+		}
+
+		if line, ok := seen[rows[i].ExternalID]; ok {
+			rows[i].Status = entity.RowSkipped
+			rows[i].Error = fmt.Sprintf("same transaction as line %d", line)
+			continue
+		}
+		seen[rows[i].ExternalID] = rows[i].Line
+	}
+
+	return nil
+}
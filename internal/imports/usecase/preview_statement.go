@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+	"github.com/MarioGN/finance-manager-api/internal/imports/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+type StatementFormat string
+
+const (
+	// StatementOFX expects an OFX or QFX bank or credit card statement, in
+	// the SGML syntax of OFX 1.x or the XML syntax of OFX 2.x.
+	StatementOFX StatementFormat = "ofx"
+	// StatementCAMT053 expects an ISO 20022 camt.053 bank to customer
+	// statement.
+	StatementCAMT053 StatementFormat = "camt053"
+)
+
+type PreviewStatementUseCase struct {
+	store data.Store
+}
+
+func NewPreviewStatementUseCase(store data.Store) *PreviewStatementUseCase {
+	return &PreviewStatementUseCase{store: store}
+}
+
+// Execute parses an OFX or CAMT.053 statement, flags the transactions that
+// were already imported or duplicate existing expenses and keeps the result
+// as a pending import, like a CSV statement.
+func (uc *PreviewStatementUseCase) Execute(userID int64, format StatementFormat, filename string, file io.Reader) (result *dto.ImportDTO, err error) {
+	content, err := io.ReadAll(io.LimitReader(file, MaxImportSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(content) > MaxImportSize {
+		return nil, fmt.Errorf("%w: the file is larger than %d bytes", ErrInvalidImport, MaxImportSize)
+	}
+
+	var rows []entity.Row
+
+	switch format {
+	case StatementOFX:
+		rows, err = parseOFX(content)
+	case StatementCAMT053:
+		rows, err = parseCAMT053(content)
+	default:
+		return nil, fmt.Errorf("%w: format must be ofx or camt053", ErrInvalidImport)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	return savePreview(uc.store, userID, string(format), filename, rows)
+}
+
+// parseStatementAmount parses a decimal amount as written in OFX and
+// CAMT.053 files: a point or, in some OFX files, a comma before the
+// decimals, and possibly more than two decimals as long as they are zeros.
+func parseStatementAmount(value string) (int64, error) {
+	s := strings.TrimSpace(value)
+	if !strings.Contains(s, ".") {
+		s = strings.Replace(s, ",", ".", 1)
+	}
+
+	if point := strings.IndexByte(s, '.'); point >= 0 {
+		for len(s)-point-1 > 2 && strings.HasSuffix(s, "0") {
+			s = s[:len(s)-1]
+		}
+	}
+
+	amount, err := money.Parse(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(amount), nil
+}
+
+// statementRow builds the row of a transaction. debit is the amount leaving
+// the account, negative for a credit, which is skipped.
+func statementRow(line int, date string, debit int64, description, externalID, currency string) entity.Row {
+	parsed, err := parseStatementDate(date)
+	if err != nil {
+		return entity.InvalidRow(line, fmt.Sprintf("invalid date %q", date))
+	}
+
+	if currency != "" {
+		code, err := money.ParseCurrency(currency)
+		if err != nil {
+			return entity.InvalidRow(line, fmt.Sprintf("invalid currency %q", currency))
+		}
+		currency = code
+	}
+
+	row := entity.NewRow(line, parsed, debit, description)
+	row.ExternalID = externalID
+	row.Currency = currency
+
+	if debit <= 0 {
+		row.Status = entity.RowSkipped
+		row.Error = "not an expense"
+	}
+
+	return row
+}
+
+// statementExternalID scopes the bank's reference for a transaction to the
+// format and the account, as references are only unique per account.
+func statementExternalID(format StatementFormat, account, reference string) string {
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return ""
+	}
+
+	account = strings.ReplaceAll(strings.TrimSpace(account), " ", "")
+	if account == "" {
+		return string(format) + ":" + reference
+	}
+
+	return string(format) + ":" + account + ":" + reference
+}
+
+// joinDescription joins the distinct non-empty parts of a description, such
+// as a payee and a memo.
+func joinDescription(parts ...string) string {
+	kept := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.Join(strings.Fields(part), " ")
+		if part == "" {
+			continue
+		}
+
+		duplicate := false
+		for _, k := range kept {
+			if strings.EqualFold(k, part) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			kept = append(kept, part)
+		}
+	}
+
+	return strings.Join(kept, " - ")
+}
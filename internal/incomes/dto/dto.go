@@ -0,0 +1,32 @@
+package dto
+
+import "github.com/MarioGN/finance-manager-api/pkg/money"
+
+type IncomeDTO struct {
+	ID        string       `json:"id,omitempty"`
+	Amount    money.Amount `json:"amount"`
+	Currency  string       `json:"currency"`
+	Source    string       `json:"source"`
+	Date      string       `json:"date"`
+	Recurring bool         `json:"recurring"`
+	AccountID string       `json:"account_id,omitempty"`
+}
+
+type IncomeQueryDTO struct {
+	From      string `query:"from"`
+	To        string `query:"to"`
+	Source    string `query:"source"`
+	Recurring string `query:"recurring"`
+	AccountID string `query:"account_id"`
+	Sort      string `query:"sort"`
+	Order     string `query:"order"`
+	Limit     int    `query:"limit"`
+	Offset    int    `query:"offset"`
+}
+
+type IncomeListDTO struct {
+	Items  []IncomeDTO `json:"items"`
+	Total  int64       `json:"total"`
+	Limit  int         `json:"limit"`
+	Offset int         `json:"offset"`
+}
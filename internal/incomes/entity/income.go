@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/incomes/dto"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/google/uuid"
+)
+
+const maxSourceLength = 100
+
+type Income struct {
+	id        string
+	userID    int64
+	amount    int64
+	currency  string
+	source    string
+	date      time.Time
+	recurring bool
+	accountID string
+}
+
+func NewIncome(userID int64, amount int64, source string, date time.Time, recurring bool) (*Income, error) {
+	if userID <= 0 {
+		return nil, errors.New("income must belong to a user")
+	}
+
+	i := &Income{
+		id:        uuid.New().String(),
+		userID:    userID,
+		currency:  money.DefaultCurrency,
+		recurring: recurring,
+	}
+
+	if err := i.SetAmount(amount); err != nil {
+		return nil, err
+	}
+
+	if err := i.SetSource(source); err != nil {
+		return nil, err
+	}
+
+	if err := i.SetDate(date); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+func (i *Income) SetAmount(amount int64) error {
+	if amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	i.amount = amount
+	return nil
+}
+
+// SetCurrency sets the ISO 4217 currency of the amount.
+func (i *Income) SetCurrency(currency string) error {
+	code, err := money.ParseCurrency(currency)
+	if err != nil {
+		return err
+	}
+	i.currency = code
+	return nil
+}
+
+// SetSource sets where the income comes from, e.g. an employer or a client.
+func (i *Income) SetSource(source string) error {
+	source = strings.TrimSpace(source)
+
+	if source == "" {
+		return errors.New("source cannot be empty")
+	}
+
+	if len(source) > maxSourceLength {
+		return errors.New("source must be at most 100 characters long")
+	}
+
+	i.source = source
+	return nil
+}
+
+func (i *Income) SetDate(date time.Time) error {
+	if date.IsZero() {
+		return errors.New("date must be a valid date")
+	}
+	i.date = date
+	return nil
+}
+
+func (i *Income) SetRecurring(recurring bool) {
+	i.recurring = recurring
+}
+
+// SetAccountID records which account received the income, or clears it
+// when accountID is empty. Whether the account exists is checked by the use
+// cases.
+func (i *Income) SetAccountID(accountID string) {
+	i.accountID = accountID
+}
+
+func (i *Income) ToDTO() *dto.IncomeDTO {
+	return &dto.IncomeDTO{
+		ID:        i.id,
+		Amount:    money.Amount(i.amount),
+		Currency:  i.currency,
+		Source:    i.source,
+		Date:      i.date.Format("2006-01-02"),
+		Recurring: i.recurring,
+		AccountID: i.accountID,
+	}
+}
+
+func (i *Income) ID() string {
+	return i.id
+}
+
+func (i *Income) UserID() int64 {
+	return i.userID
+}
+
+func (i *Income) Amount() int64 {
+	return i.amount
+}
+
+func (i *Income) Currency() string {
+	return i.currency
+}
+
+func (i *Income) Source() string {
+	return i.source
+}
+
+func (i *Income) Date() time.Time {
+	return i.date
+}
+
+func (i *Income) Recurring() bool {
+	return i.recurring
+}
+
+func (i *Income) AccountID() string {
+	return i.accountID
+}
+
+func (i *Income) SetID(id string) {
+	i.id = id
+}
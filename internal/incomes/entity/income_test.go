@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIncome(t *testing.T) {
+	date := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		userID  int64
+		amount  int64
+		source  string
+		date    time.Time
+		wantErr bool
+	}{
+		{name: "Valid salary", userID: 1, amount: 500000, source: "  ACME Corp ", date: date},
+		{name: "Missing user", userID: 0, amount: 100, source: "Client", date: date, wantErr: true},
+		{name: "Zero amount", userID: 1, amount: 0, source: "Client", date: date, wantErr: true},
+		{name: "Negative amount", userID: 1, amount: -100, source: "Client", date: date, wantErr: true},
+		{name: "Blank source", userID: 1, amount: 100, source: "   ", date: date, wantErr: true},
+		{name: "Source too long", userID: 1, amount: 100, source: strings.Repeat("x", 101), date: date, wantErr: true},
+		{name: "Zero date", userID: 1, amount: 100, source: "Client", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			income, err := NewIncome(tt.userID, tt.amount, tt.source, tt.date, true)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, income)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "ACME Corp", income.Source())
+			assert.True(t, income.Recurring())
+			assert.NotEmpty(t, income.ID())
+		})
+	}
+}
+
+func TestIncome_ToDTO(t *testing.T) {
+	income, err := NewIncome(1, 123456, "Freelance", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), false)
+	require.NoError(t, err)
+
+	d := income.ToDTO()
+	assert.Equal(t, income.ID(), d.ID)
+	assert.Equal(t, "1234.56", d.Amount.String())
+	assert.Equal(t, "Freelance", d.Source)
+	assert.Equal(t, "2026-03-15", d.Date)
+	assert.False(t, d.Recurring)
+}
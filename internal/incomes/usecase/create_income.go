@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	accounts "github.com/MarioGN/finance-manager-api/internal/accounts/usecase"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/dto"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var (
+	ErrInvalidIncome   = appErrors.Validation("invalid income")
+	ErrInvalidAccount  = appErrors.Validation("account does not exist")
+	ErrInvalidCurrency = accounts.ErrInvalidCurrency
+)
+
+type CreateIncomeUseCase struct {
+	store data.Store
+}
+
+func NewCreateIncomeUseCase(store data.Store) *CreateIncomeUseCase {
+	return &CreateIncomeUseCase{store: store}
+}
+
+func (uc *CreateIncomeUseCase) Execute(userID int64, input dto.IncomeDTO) (result *dto.IncomeDTO, err error) {
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: date must be a YYYY-MM-DD date", ErrInvalidIncome)
+	}
+
+	income, err := entity.NewIncome(userID, int64(input.Amount), input.Source, date, input.Recurring)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIncome, err)
+	}
+
+	currency, err := accountCurrency(uc.store, userID, input.AccountID, input.Currency, "")
+	if err != nil {
+		return nil, err
+	}
+	income.SetAccountID(input.AccountID)
+	if err := income.SetCurrency(currency); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCurrency, err)
+	}
+
+	if err := uc.store.Incomes.Save(*income); err != nil {
+		return nil, fmt.Errorf("failed to save income: %w", err)
+	}
+
+	return income.ToDTO(), nil
+}
+
+// accountCurrency resolves the currency of an income, see
+// accounts.ResolveCurrency.
+func accountCurrency(store data.Store, userID int64, accountID, requested, current string) (string, error) {
+	currency, err := accounts.ResolveCurrency(store, userID, accountID, requested, current)
+	if errors.Is(err, data.ErrAccountNotFound) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidAccount, accountID)
+	}
+
+	return currency, err
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+)
+
+type DeleteIncomeUseCase struct {
+	store data.Store
+}
+
+func NewDeleteIncomeUseCase(store data.Store) *DeleteIncomeUseCase {
+	return &DeleteIncomeUseCase{store: store}
+}
+
+func (uc *DeleteIncomeUseCase) Execute(userID int64, id string) error {
+	if err := uc.store.Incomes.Delete(userID, id); err != nil {
+		return fmt.Errorf("failed to delete income: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/dto"
+)
+
+type GetIncomeUseCase struct {
+	store data.Store
+}
+
+func NewGetIncomeUseCase(store data.Store) *GetIncomeUseCase {
+	return &GetIncomeUseCase{store: store}
+}
+
+func (uc *GetIncomeUseCase) Execute(userID int64, id string) (result *dto.IncomeDTO, err error) {
+	income, err := uc.store.Incomes.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find income by ID: %w", err)
+	}
+
+	return income.ToDTO(), nil
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/dto"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+var ErrInvalidIncomeQuery = appErrors.Validation("invalid income query")
+
+type GetIncomesUseCase struct {
+	store data.Store
+}
+
+func NewGetIncomesUseCase(store data.Store) *GetIncomesUseCase {
+	return &GetIncomesUseCase{store: store}
+}
+
+func (uc *GetIncomesUseCase) Execute(userID int64, query dto.IncomeQueryDTO) (result *dto.IncomeListDTO, err error) {
+	filter, err := buildIncomeFilter(userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	incomes, err := uc.store.Incomes.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incomes: %w", err)
+	}
+
+	total, err := uc.store.Incomes.Count(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count incomes: %w", err)
+	}
+
+	result = &dto.IncomeListDTO{
+		Items:  make([]dto.IncomeDTO, 0, len(incomes)),
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+
+	for _, i := range incomes {
+		result.Items = append(result.Items, *i.ToDTO())
+	}
+
+	return result, nil
+}
+
+func buildIncomeFilter(userID int64, query dto.IncomeQueryDTO) (data.IncomeFilter, error) {
+	filter := data.IncomeFilter{
+		UserID:    userID,
+		Source:    strings.TrimSpace(query.Source),
+		AccountID: query.AccountID,
+		SortField: data.IncomeSortByDate,
+		SortDesc:  true,
+		Limit:     DefaultPageSize,
+		Offset:    query.Offset,
+	}
+
+	var err error
+
+	if filter.From, err = parseOptionalDate(query.From); err != nil {
+		return filter, fmt.Errorf("%w: from must be a YYYY-MM-DD date", ErrInvalidIncomeQuery)
+	}
+
+	if filter.To, err = parseOptionalDate(query.To); err != nil {
+		return filter, fmt.Errorf("%w: to must be a YYYY-MM-DD date", ErrInvalidIncomeQuery)
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return filter, fmt.Errorf("%w: from must not be after to", ErrInvalidIncomeQuery)
+	}
+
+	if query.Recurring != "" {
+		recurring, err := strconv.ParseBool(query.Recurring)
+		if err != nil {
+			return filter, fmt.Errorf("%w: recurring must be true or false", ErrInvalidIncomeQuery)
+		}
+		filter.Recurring = &recurring
+	}
+
+	if query.Sort != "" {
+		filter.SortField = data.IncomeSortField(query.Sort)
+		if !filter.SortField.IsValid() {
+			return filter, fmt.Errorf("%w: cannot sort by %q", ErrInvalidIncomeQuery, query.Sort)
+		}
+	}
+
+	switch strings.ToLower(query.Order) {
+	case "", "desc":
+	case "asc":
+		filter.SortDesc = false
+	default:
+		return filter, fmt.Errorf("%w: order must be asc or desc", ErrInvalidIncomeQuery)
+	}
+
+	if query.Limit < 0 || query.Limit > MaxPageSize {
+		return filter, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidIncomeQuery, MaxPageSize)
+	}
+	if query.Limit > 0 {
+		filter.Limit = query.Limit
+	}
+
+	if query.Offset < 0 {
+		return filter, fmt.Errorf("%w: offset must not be negative", ErrInvalidIncomeQuery)
+	}
+
+	return filter, nil
+}
+
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/dto"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockIncomeRepository implements data.IncomeRepository for testing
+type MockIncomeRepository struct {
+	data.IncomeRepository
+
+	incomes    []entity.Income
+	total      int64
+	lastFilter data.IncomeFilter
+}
+
+func (m *MockIncomeRepository) FindAll(filter data.IncomeFilter) ([]entity.Income, error) {
+	m.lastFilter = filter
+	return m.incomes, nil
+}
+
+func (m *MockIncomeRepository) Count(filter data.IncomeFilter) (int64, error) {
+	return m.total, nil
+}
+
+func TestGetIncomes_BuildsFilterFromQuery(t *testing.T) {
+	mockRepo := &MockIncomeRepository{total: 3}
+	uc := NewGetIncomesUseCase(data.Store{Incomes: mockRepo})
+
+	result, err := uc.Execute(7, dto.IncomeQueryDTO{
+		From:      "2026-01-01",
+		Source:    " acme ",
+		Recurring: "true",
+		Sort:      "amount",
+		Order:     "asc",
+		Limit:     10,
+	})
+	require.NoError(t, err)
+
+	filter := mockRepo.lastFilter
+	assert.Equal(t, int64(7), filter.UserID)
+	assert.Equal(t, "2026-01-01", filter.From.Format("2006-01-02"))
+	assert.Nil(t, filter.To)
+	assert.Equal(t, "acme", filter.Source)
+	require.NotNil(t, filter.Recurring)
+	assert.True(t, *filter.Recurring)
+	assert.Equal(t, data.IncomeSortByAmount, filter.SortField)
+	assert.False(t, filter.SortDesc)
+
+	assert.Equal(t, int64(3), result.Total)
+	assert.Equal(t, 10, result.Limit)
+	assert.NotNil(t, result.Items, "Items should be an empty list, not null")
+}
+
+func TestGetIncomes_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query dto.IncomeQueryDTO
+	}{
+		{name: "Malformed to", query: dto.IncomeQueryDTO{To: "31/01/2026"}},
+		{name: "From after to", query: dto.IncomeQueryDTO{From: "2026-02-01", To: "2026-01-01"}},
+		{name: "Malformed recurring", query: dto.IncomeQueryDTO{Recurring: "sometimes"}},
+		{name: "Unknown sort field", query: dto.IncomeQueryDTO{Sort: "user_id"}},
+		{name: "Unknown order", query: dto.IncomeQueryDTO{Order: "up"}},
+		{name: "Limit too large", query: dto.IncomeQueryDTO{Limit: MaxPageSize + 1}},
+		{name: "Negative offset", query: dto.IncomeQueryDTO{Offset: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewGetIncomesUseCase(data.Store{Incomes: &MockIncomeRepository{}})
+
+			result, err := uc.Execute(7, tt.query)
+
+			assert.ErrorIs(t, err, ErrInvalidIncomeQuery)
+			assert.Nil(t, result)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/dto"
+)
+
+type UpdateIncomeUseCase struct {
+	store data.Store
+}
+
+func NewUpdateIncomeUseCase(store data.Store) *UpdateIncomeUseCase {
+	return &UpdateIncomeUseCase{store: store}
+}
+
+func (uc *UpdateIncomeUseCase) Execute(userID int64, id string, input dto.IncomeDTO) (result *dto.IncomeDTO, err error) {
+	income, err := uc.store.Incomes.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find income by ID: %w", err)
+	}
+
+	if err := income.SetAmount(int64(input.Amount)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIncome, err)
+	}
+
+	if err := income.SetSource(input.Source); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIncome, err)
+	}
+
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: date must be a YYYY-MM-DD date", ErrInvalidIncome)
+	}
+	if err := income.SetDate(date); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIncome, err)
+	}
+
+	income.SetRecurring(input.Recurring)
+
+	currency, err := accountCurrency(uc.store, userID, input.AccountID, input.Currency, income.Currency())
+	if err != nil {
+		return nil, err
+	}
+	income.SetAccountID(input.AccountID)
+	if err := income.SetCurrency(currency); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCurrency, err)
+	}
+
+	if err := uc.store.Incomes.Update(*income); err != nil {
+		return nil, fmt.Errorf("failed to save income: %w", err)
+	}
+
+	return income.ToDTO(), nil
+}
@@ -0,0 +1,60 @@
+package dto
+
+import "encoding/json"
+
+// WebhookDTO is a URL that receives signed event notifications. The secret
+// used to sign deliveries is only returned when the webhook is created.
+type WebhookDTO struct {
+	ID     string `json:"id,omitempty"`
+	URL    string `json:"url"`
+	Active *bool  `json:"active,omitempty"`
+	Secret string `json:"secret,omitempty"`
+}
+
+type DeliveryDTO struct {
+	ID             string          `json:"id"`
+	WebhookID      string          `json:"webhook_id"`
+	Event          string          `json:"event"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	CreatedAt      string          `json:"created_at"`
+	NextAttemptAt  string          `json:"next_attempt_at,omitempty"`
+	DeliveredAt    string          `json:"delivered_at,omitempty"`
+}
+
+type DeliveryQueryDTO struct {
+	Status string `query:"status"`
+	Limit  int    `query:"limit"`
+	Offset int    `query:"offset"`
+}
+
+type DeliveryListDTO struct {
+	Items  []DeliveryDTO `json:"items"`
+	Total  int64         `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+}
+
+// BudgetAlertDTO is the payload of a budget.threshold_reached event.
+type BudgetAlertDTO struct {
+	BudgetID    string  `json:"budget_id"`
+	Month       string  `json:"month"`
+	Threshold   int     `json:"threshold"`
+	CategoryID  string  `json:"category_id,omitempty"`
+	ExpenseType string  `json:"expense_type,omitempty"`
+	Currency    string  `json:"currency"`
+	Limit       string  `json:"limit"`
+	Spent       string  `json:"spent"`
+	PercentUsed float64 `json:"percent_used"`
+}
+
+// EventDTO is the body POSTed to a webhook.
+type EventDTO struct {
+	ID        string `json:"id"`
+	Event     string `json:"event"`
+	CreatedAt string `json:"created_at"`
+	Data      any    `json:"data"`
+}
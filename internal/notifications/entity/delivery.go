@@ -0,0 +1,215 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+	"github.com/google/uuid"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+func (s DeliveryStatus) IsValid() bool {
+	switch s {
+	case DeliveryPending, DeliveryDelivered, DeliveryFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// MaxDeliveryAttempts is how many times a delivery is tried before it is
+// given up as failed.
+const MaxDeliveryAttempts = 8
+
+const (
+	firstRetryDelay = 30 * time.Second
+	maxRetryDelay   = 6 * time.Hour
+)
+
+// Delivery is one event sent, or still to be sent, to one webhook.
+type Delivery struct {
+	id             string
+	userID         int64
+	webhookID      string
+	event          string
+	payload        []byte
+	status         DeliveryStatus
+	attempts       int
+	lastStatusCode int
+	lastError      string
+	createdAt      time.Time
+	nextAttemptAt  *time.Time
+	deliveredAt    *time.Time
+}
+
+func NewDelivery(webhook Webhook, event string, payload []byte, now time.Time) *Delivery {
+	next := timestamp(now)
+	return &Delivery{
+		id:            uuid.New().String(),
+		userID:        webhook.UserID(),
+		webhookID:     webhook.ID(),
+		event:         event,
+		payload:       payload,
+		status:        DeliveryPending,
+		createdAt:     next,
+		nextAttemptAt: &next,
+	}
+}
+
+// timestamp keeps whole seconds in UTC, the precision deliveries are stored
+// with.
+func timestamp(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Second)
+}
+
+// RestoreDelivery rebuilds a delivery from persisted data.
+func RestoreDelivery(
+	id string,
+	userID int64,
+	webhookID, event string,
+	payload []byte,
+	status DeliveryStatus,
+	attempts, lastStatusCode int,
+	lastError string,
+	createdAt time.Time,
+	nextAttemptAt, deliveredAt *time.Time,
+) *Delivery {
+	return &Delivery{
+		id:             id,
+		userID:         userID,
+		webhookID:      webhookID,
+		event:          event,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		lastStatusCode: lastStatusCode,
+		lastError:      lastError,
+		createdAt:      createdAt,
+		nextAttemptAt:  nextAttemptAt,
+		deliveredAt:    deliveredAt,
+	}
+}
+
+// Succeed records an attempt the webhook accepted.
+func (d *Delivery) Succeed(statusCode int, now time.Time) {
+	at := timestamp(now)
+	d.attempts++
+	d.status = DeliveryDelivered
+	d.lastStatusCode = statusCode
+	d.lastError = ""
+	d.nextAttemptAt = nil
+	d.deliveredAt = &at
+}
+
+// Fail records a rejected or unreachable attempt and schedules the next one,
+// doubling the delay each time, until MaxDeliveryAttempts is reached.
+func (d *Delivery) Fail(statusCode int, reason string, now time.Time) {
+	d.attempts++
+	d.lastStatusCode = statusCode
+	d.lastError = reason
+
+	if d.attempts >= MaxDeliveryAttempts {
+		d.status = DeliveryFailed
+		d.nextAttemptAt = nil
+		return
+	}
+
+	next := timestamp(now).Add(RetryDelay(d.attempts))
+	d.nextAttemptAt = &next
+}
+
+// Abandon gives up on the delivery without another attempt.
+func (d *Delivery) Abandon(reason string) {
+	d.status = DeliveryFailed
+	d.lastError = reason
+	d.nextAttemptAt = nil
+}
+
+// RetryDelay is how long to wait after the given number of failed attempts.
+func RetryDelay(attempts int) time.Duration {
+	delay := firstRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+func (d *Delivery) ToDTO() *dto.DeliveryDTO {
+	result := &dto.DeliveryDTO{
+		ID:             d.id,
+		WebhookID:      d.webhookID,
+		Event:          d.event,
+		Payload:        json.RawMessage(d.payload),
+		Status:         string(d.status),
+		Attempts:       d.attempts,
+		LastStatusCode: d.lastStatusCode,
+		LastError:      d.lastError,
+		CreatedAt:      d.createdAt.Format(time.RFC3339),
+	}
+
+	if d.nextAttemptAt != nil {
+		result.NextAttemptAt = d.nextAttemptAt.Format(time.RFC3339)
+	}
+
+	if d.deliveredAt != nil {
+		result.DeliveredAt = d.deliveredAt.Format(time.RFC3339)
+	}
+
+	return result
+}
+
+func (d *Delivery) ID() string {
+	return d.id
+}
+
+func (d *Delivery) UserID() int64 {
+	return d.userID
+}
+
+func (d *Delivery) WebhookID() string {
+	return d.webhookID
+}
+
+func (d *Delivery) Event() string {
+	return d.event
+}
+
+func (d *Delivery) Payload() []byte {
+	return d.payload
+}
+
+func (d *Delivery) Status() DeliveryStatus {
+	return d.status
+}
+
+func (d *Delivery) Attempts() int {
+	return d.attempts
+}
+
+func (d *Delivery) LastStatusCode() int {
+	return d.lastStatusCode
+}
+
+func (d *Delivery) LastError() string {
+	return d.lastError
+}
+
+func (d *Delivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *Delivery) NextAttemptAt() *time.Time {
+	return d.nextAttemptAt
+}
+
+func (d *Delivery) DeliveredAt() *time.Time {
+	return d.deliveredAt
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, RetryDelay(1))
+	assert.Equal(t, time.Minute, RetryDelay(2))
+	assert.Equal(t, 4*time.Minute, RetryDelay(4))
+	assert.Equal(t, 6*time.Hour, RetryDelay(20), "The delay should be capped")
+}
+
+func TestDelivery_Attempts(t *testing.T) {
+	webhook, err := NewWebhook(1, "https://example.com/hook")
+	require.NoError(t, err)
+
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	delivery := NewDelivery(*webhook, "budget.threshold_reached", []byte(`{}`), now)
+	assert.Equal(t, DeliveryPending, delivery.Status())
+	assert.Equal(t, now, *delivery.NextAttemptAt())
+
+	delivery.Fail(503, "503 Service Unavailable", now)
+	assert.Equal(t, DeliveryPending, delivery.Status())
+	assert.Equal(t, now.Add(30*time.Second), *delivery.NextAttemptAt())
+
+	for delivery.Status() == DeliveryPending {
+		delivery.Fail(0, "connection refused", now)
+	}
+	assert.Equal(t, DeliveryFailed, delivery.Status())
+	assert.Equal(t, MaxDeliveryAttempts, delivery.Attempts())
+	assert.Nil(t, delivery.NextAttemptAt())
+
+	retried := NewDelivery(*webhook, "budget.threshold_reached", []byte(`{}`), now)
+	retried.Fail(500, "500 Internal Server Error", now)
+	retried.Succeed(204, now.Add(time.Minute))
+	assert.Equal(t, DeliveryDelivered, retried.Status())
+	assert.Equal(t, 2, retried.Attempts())
+	assert.Empty(t, retried.LastError())
+	assert.Nil(t, retried.NextAttemptAt())
+}
+
+func TestWebhook_URL(t *testing.T) {
+	for _, url := range []string{"", "example.com/hook", "ftp://example.com", "http://"} {
+		_, err := NewWebhook(1, url)
+		assert.Error(t, err, url)
+	}
+
+	webhook, err := NewWebhook(1, "http://localhost:8080/hook")
+	require.NoError(t, err)
+	assert.True(t, webhook.Active())
+	assert.Regexp(t, `^whsec_[0-9a-f]{64}$`, webhook.Secret())
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+	"github.com/google/uuid"
+)
+
+// Webhook is a URL that receives the user's events, signed with secret.
+type Webhook struct {
+	id     string
+	userID int64
+	url    string
+	secret string
+	active bool
+}
+
+func NewWebhook(userID int64, rawURL string) (*Webhook, error) {
+	if userID <= 0 {
+		return nil, errors.New("webhook must belong to a user")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	w := &Webhook{
+		id:     uuid.New().String(),
+		userID: userID,
+		secret: "whsec_" + hex.EncodeToString(secret),
+		active: true,
+	}
+
+	if err := w.SetURL(rawURL); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// RestoreWebhook rebuilds a webhook from persisted data.
+func RestoreWebhook(id string, userID int64, rawURL, secret string, active bool) *Webhook {
+	return &Webhook{id: id, userID: userID, url: rawURL, secret: secret, active: active}
+}
+
+// SetURL sets where deliveries are POSTed. Only absolute http and https URLs
+// are accepted.
+func (w *Webhook) SetURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+	w.url = u.String()
+	return nil
+}
+
+func (w *Webhook) SetActive(active bool) {
+	w.active = active
+}
+
+func (w *Webhook) ToDTO() *dto.WebhookDTO {
+	active := w.active
+	return &dto.WebhookDTO{
+		ID:     w.id,
+		URL:    w.url,
+		Active: &active,
+	}
+}
+
+func (w *Webhook) ID() string {
+	return w.id
+}
+
+func (w *Webhook) UserID() int64 {
+	return w.userID
+}
+
+func (w *Webhook) URL() string {
+	return w.url
+}
+
+func (w *Webhook) Secret() string {
+	return w.secret
+}
+
+func (w *Webhook) Active() bool {
+	return w.active
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	budgetDTO "github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+	budgets "github.com/MarioGN/finance-manager-api/internal/budgets/usecase"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+	"github.com/google/uuid"
+)
+
+// EventBudgetThreshold is sent when a budget reaches one of
+// BudgetThresholds percent of its limit.
+const EventBudgetThreshold = "budget.threshold_reached"
+
+var BudgetThresholds = []int{80, 100}
+
+var now = time.Now
+
+type CheckBudgetsUseCase struct {
+	store data.Store
+}
+
+func NewCheckBudgetsUseCase(store data.Store) *CheckBudgetsUseCase {
+	return &CheckBudgetsUseCase{store: store}
+}
+
+// Execute checks the user's budgets for the months of dates and queues an
+// event for every threshold newly reached. Each threshold is raised once a
+// month; it is re-armed when spending falls back below it. A threshold whose
+// event cannot be queued is left unclaimed, so the next check raises it.
+func (uc *CheckBudgetsUseCase) Execute(userID int64, dates ...time.Time) error {
+	checked := make(map[string]bool)
+
+	for _, date := range dates {
+		month := date.Format("2006-01")
+		if checked[month] {
+			continue
+		}
+		checked[month] = true
+
+		status, err := budgets.NewGetBudgetStatusUseCase(uc.store).Execute(userID, budgetDTO.BudgetStatusQueryDTO{Month: month})
+		if err != nil {
+			return fmt.Errorf("failed to get budget status: %w", err)
+		}
+
+		start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		for _, item := range status.Items {
+			if err := uc.checkBudget(userID, start, item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (uc *CheckBudgetsUseCase) checkBudget(userID int64, month time.Time, item budgetDTO.BudgetStatusDTO) error {
+	for _, threshold := range BudgetThresholds {
+		alert := data.BudgetAlert{BudgetID: item.BudgetID, Month: month, Threshold: threshold}
+
+		if item.PercentUsed < float64(threshold) {
+			if err := uc.store.Budgets.ReleaseAlert(alert); err != nil {
+				return fmt.Errorf("failed to release budget alert: %w", err)
+			}
+			continue
+		}
+
+		err := uc.store.Budgets.ClaimAlert(alert)
+		if errors.Is(err, data.ErrAlertRaised) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to record budget alert: %w", err)
+		}
+
+		err = uc.publish(userID, EventBudgetThreshold, dto.BudgetAlertDTO{
+			BudgetID:    item.BudgetID,
+			Month:       month.Format("2006-01"),
+			Threshold:   threshold,
+			CategoryID:  item.CategoryID,
+			ExpenseType: item.ExpenseType,
+			Currency:    item.Currency,
+			Limit:       item.Limit.String(),
+			Spent:       item.Spent.String(),
+			PercentUsed: item.PercentUsed,
+		})
+		if err != nil {
+			if releaseErr := uc.store.Budgets.ReleaseAlert(alert); releaseErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to release budget alert: %w", releaseErr))
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publish queues the event for every active webhook of the user. All the
+// deliveries of one event share its ID.
+func (uc *CheckBudgetsUseCase) publish(userID int64, event string, body any) error {
+	webhooks, err := uc.store.Webhooks.FindAll(userID)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	at := now()
+
+	payload, err := json.Marshal(dto.EventDTO{
+		ID:        uuid.New().String(),
+		Event:     event,
+		CreatedAt: at.UTC().Format(time.RFC3339),
+		Data:      body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Active() {
+			continue
+		}
+
+		if err := uc.store.Webhooks.SaveDelivery(*entity.NewDelivery(webhook, event, payload, at)); err != nil {
+			return fmt.Errorf("failed to queue delivery: %w", err)
+		}
+	}
+
+	return nil
+}
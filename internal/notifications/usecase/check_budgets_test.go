@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	budgetEntity "github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBudgetRepository implements data.BudgetRepository in memory for
+// testing
+type MockBudgetRepository struct {
+	data.BudgetRepository
+
+	budgets []budgetEntity.Budget
+	alerts  map[data.BudgetAlert]bool
+}
+
+func (m *MockBudgetRepository) FindAll(userID int64) ([]budgetEntity.Budget, error) {
+	return m.budgets, nil
+}
+
+func (m *MockBudgetRepository) ClaimAlert(alert data.BudgetAlert) error {
+	if m.alerts[alert] {
+		return fmt.Errorf("%w: %d", data.ErrAlertRaised, alert.Threshold)
+	}
+	m.alerts[alert] = true
+	return nil
+}
+
+func (m *MockBudgetRepository) ReleaseAlert(alert data.BudgetAlert) error {
+	delete(m.alerts, alert)
+	return nil
+}
+
+// MockReportRepository reports the same spending for every budget
+type MockReportRepository struct {
+	data.ReportRepository
+
+	spent int64
+}
+
+func (m *MockReportRepository) SumExpenses(filter data.SpendingFilter) (int64, error) {
+	return m.spent, nil
+}
+
+// MockFailingWebhookRepository fails to queue deliveries while failing is
+// set
+type MockFailingWebhookRepository struct {
+	*MockWebhookRepository
+
+	failing bool
+}
+
+func (m *MockFailingWebhookRepository) SaveDelivery(delivery entity.Delivery) error {
+	if m.failing {
+		return errors.New("database is locked")
+	}
+	return m.MockWebhookRepository.SaveDelivery(delivery)
+}
+
+func TestCheckBudgets(t *testing.T) {
+	now = func() time.Time { return time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	budget, err := budgetEntity.NewBudget(1, nil, "", expenseEntity.VariableExpense, 10000, "EUR")
+	require.NoError(t, err)
+
+	active, err := entity.NewWebhook(1, "https://example.com/active")
+	require.NoError(t, err)
+	inactive, err := entity.NewWebhook(1, "https://example.com/inactive")
+	require.NoError(t, err)
+	inactive.SetActive(false)
+
+	budgets := &MockBudgetRepository{budgets: []budgetEntity.Budget{*budget}, alerts: map[data.BudgetAlert]bool{}}
+	reports := &MockReportRepository{}
+	webhooks := newMockWebhookRepository(active, inactive)
+	uc := NewCheckBudgetsUseCase(data.Store{Budgets: budgets, Reports: reports, Webhooks: webhooks})
+
+	april := time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC)
+
+	thresholds := func() []int {
+		result := make([]int, 0)
+		for _, d := range webhooks.deliveries {
+			assert.Equal(t, active.ID(), d.WebhookID(), "Inactive webhooks should get nothing")
+			assert.Equal(t, EventBudgetThreshold, d.Event())
+
+			var event struct {
+				Data dto.BudgetAlertDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(d.Payload(), &event))
+			assert.Equal(t, budget.ID(), event.Data.BudgetID)
+			result = append(result, event.Data.Threshold)
+		}
+		return result
+	}
+
+	reports.spent = 7000
+	require.NoError(t, uc.Execute(1, april))
+	assert.Empty(t, thresholds(), "Nothing should be sent below 80%")
+
+	reports.spent = 8000
+	require.NoError(t, uc.Execute(1, april, april))
+	assert.Equal(t, []int{80}, thresholds())
+
+	reports.spent = 9000
+	require.NoError(t, uc.Execute(1, april))
+	assert.Equal(t, []int{80}, thresholds(), "A threshold should only be raised once")
+
+	reports.spent = 12000
+	require.NoError(t, uc.Execute(1, april))
+	assert.Equal(t, []int{80, 100}, thresholds())
+
+	reports.spent = 5000
+	require.NoError(t, uc.Execute(1, april))
+	reports.spent = 8500
+	require.NoError(t, uc.Execute(1, april))
+	assert.Equal(t, []int{80, 100, 80}, thresholds(), "Falling below a threshold should re-arm it")
+
+	require.NoError(t, uc.Execute(1, april.AddDate(0, 1, 0)))
+	assert.Equal(t, []int{80, 100, 80, 80}, thresholds(), "Each month should have its own alerts")
+}
+
+func TestCheckBudgets_FailedPublishIsRetried(t *testing.T) {
+	budget, err := budgetEntity.NewBudget(1, nil, "", expenseEntity.VariableExpense, 10000, "EUR")
+	require.NoError(t, err)
+
+	budgets := &MockBudgetRepository{budgets: []budgetEntity.Budget{*budget}, alerts: map[data.BudgetAlert]bool{}}
+	webhook, err := entity.NewWebhook(1, "https://example.com/hook")
+	require.NoError(t, err)
+	webhooks := &MockFailingWebhookRepository{
+		MockWebhookRepository: newMockWebhookRepository(webhook),
+		failing:               true,
+	}
+	uc := NewCheckBudgetsUseCase(data.Store{Budgets: budgets, Reports: &MockReportRepository{spent: 8000}, Webhooks: webhooks})
+
+	april := time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC)
+
+	assert.Error(t, uc.Execute(1, april))
+	assert.Empty(t, budgets.alerts, "An alert that was not queued should not stay claimed")
+
+	webhooks.failing = false
+	require.NoError(t, uc.Execute(1, april))
+	assert.Len(t, webhooks.deliveries, 1, "The alert should be raised on the next check")
+}
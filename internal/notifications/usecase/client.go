@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+var ErrAddressNotAllowed = errors.New("webhooks cannot be sent to loopback, link-local or private addresses")
+
+// NewWebhookClient returns the client deliveries are sent with, each request
+// bounded by timeout. Unless allowPrivate is set, it refuses to connect to
+// loopback, link-local and private addresses, so that a webhook cannot reach
+// the server itself or the network it runs in. The addresses are checked as
+// they are dialed, after the host name is resolved and on every redirect,
+// and no proxy is used since it would be dialed instead of the webhook.
+func NewWebhookClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if !allowPrivate {
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: refusePrivateAddress}
+		transport.DialContext = dialer.DialContext
+		transport.Proxy = nil
+	}
+
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func refusePrivateAddress(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := addrPort.Addr().Unmap()
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("%w: %s", ErrAddressNotAllowed, ip)
+	}
+
+	return nil
+}
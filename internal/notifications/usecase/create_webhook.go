@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidWebhook = appErrors.Validation("invalid webhook")
+
+type CreateWebhookUseCase struct {
+	store data.Store
+}
+
+func NewCreateWebhookUseCase(store data.Store) *CreateWebhookUseCase {
+	return &CreateWebhookUseCase{store: store}
+}
+
+// Execute registers a webhook. The result is the only place the signing
+// secret is returned.
+func (uc *CreateWebhookUseCase) Execute(userID int64, input dto.WebhookDTO) (result *dto.WebhookDTO, err error) {
+	webhook, err := entity.NewWebhook(userID, input.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebhook, err)
+	}
+
+	if input.Active != nil {
+		webhook.SetActive(*input.Active)
+	}
+
+	if err := uc.store.Webhooks.Save(*webhook); err != nil {
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	result = webhook.ToDTO()
+	result.Secret = webhook.Secret()
+
+	return result, nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+)
+
+type DeleteWebhookUseCase struct {
+	store data.Store
+}
+
+func NewDeleteWebhookUseCase(store data.Store) *DeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{store: store}
+}
+
+func (uc *DeleteWebhookUseCase) Execute(userID int64, id string) error {
+	if err := uc.store.Webhooks.Delete(userID, id); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
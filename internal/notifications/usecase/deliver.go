@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+)
+
+// Headers sent with every delivery. The signature covers the timestamp and
+// the body, see Sign.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// deliveryBatchSize bounds how many deliveries one run sends.
+const deliveryBatchSize = 50
+
+const maxErrorLength = 500
+
+type DeliverUseCase struct {
+	store  data.Store
+	client *http.Client
+}
+
+func NewDeliverUseCase(store data.Store, client *http.Client) *DeliverUseCase {
+	return &DeliverUseCase{store: store, client: client}
+}
+
+// Sign returns the X-Webhook-Signature value for body sent at timestamp:
+// "sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>" keyed
+// with the webhook secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Execute sends the deliveries due at now and records the outcome of each
+// attempt. It returns how many were attempted. Failed attempts are retried
+// by later runs; only errors from the store stop the run.
+func (uc *DeliverUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	due, err := uc.store.Webhooks.FindDueDeliveries(now, deliveryBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find due deliveries: %w", err)
+	}
+
+	attempted := 0
+
+	for _, delivery := range due {
+		if ctx.Err() != nil {
+			break
+		}
+
+		webhook, err := uc.store.Webhooks.FindByID(delivery.UserID(), delivery.WebhookID())
+		switch {
+		case errors.Is(err, data.ErrWebhookNotFound):
+			delivery.Abandon("webhook was deleted")
+		case err != nil:
+			return attempted, fmt.Errorf("failed to find webhook: %w", err)
+		case !webhook.Active():
+			delivery.Abandon("webhook is inactive")
+		default:
+			if !uc.send(ctx, *webhook, &delivery, now) {
+				continue
+			}
+			attempted++
+		}
+
+		if err := uc.store.Webhooks.UpdateDelivery(delivery); err != nil {
+			return attempted, fmt.Errorf("failed to update delivery: %w", err)
+		}
+	}
+
+	return attempted, nil
+}
+
+// send POSTs the delivery and records the outcome on it. It returns false
+// when ctx was cancelled, in which case the attempt does not count.
+func (uc *DeliverUseCase) send(ctx context.Context, webhook entity.Webhook, delivery *entity.Delivery, now time.Time) bool {
+	body := delivery.Payload()
+	timestamp := now.Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL(), bytes.NewReader(body))
+	if err != nil {
+		delivery.Fail(0, truncate(err.Error()), now)
+		return true
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "finance-manager-webhooks")
+	req.Header.Set(HeaderEvent, delivery.Event())
+	req.Header.Set(HeaderDelivery, delivery.ID())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret(), timestamp, body))
+
+	resp, err := uc.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false
+		}
+		delivery.Fail(0, truncate(err.Error()), now)
+		return true
+	}
+	defer resp.Body.Close()
+
+	// Drain a little of the body so that the connection can be reused.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		delivery.Succeed(resp.StatusCode, now)
+	} else {
+		delivery.Fail(resp.StatusCode, resp.Status, now)
+	}
+
+	return true
+}
+
+// truncate shortens message to at most maxErrorLength bytes without
+// splitting a character.
+func truncate(message string) string {
+	if len(message) <= maxErrorLength {
+		return message
+	}
+
+	cut := maxErrorLength
+	for cut > 0 && !utf8.RuneStart(message[cut]) {
+		cut--
+	}
+	return message[:cut]
+}
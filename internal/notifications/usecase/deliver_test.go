@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWebhookRepository implements data.WebhookRepository in memory for
+// testing
+type MockWebhookRepository struct {
+	data.WebhookRepository
+
+	webhooks   map[string]entity.Webhook
+	deliveries []entity.Delivery
+}
+
+func newMockWebhookRepository(webhooks ...*entity.Webhook) *MockWebhookRepository {
+	m := &MockWebhookRepository{webhooks: map[string]entity.Webhook{}}
+	for _, w := range webhooks {
+		m.webhooks[w.ID()] = *w
+	}
+	return m
+}
+
+func (m *MockWebhookRepository) FindAll(userID int64) ([]entity.Webhook, error) {
+	webhooks := make([]entity.Webhook, 0)
+	for _, w := range m.webhooks {
+		if w.UserID() == userID {
+			webhooks = append(webhooks, w)
+		}
+	}
+	return webhooks, nil
+}
+
+func (m *MockWebhookRepository) FindByID(userID int64, id string) (*entity.Webhook, error) {
+	w, ok := m.webhooks[id]
+	if !ok || w.UserID() != userID {
+		return nil, fmt.Errorf("%w: %s", data.ErrWebhookNotFound, id)
+	}
+	return &w, nil
+}
+
+func (m *MockWebhookRepository) SaveDelivery(delivery entity.Delivery) error {
+	m.deliveries = append(m.deliveries, delivery)
+	return nil
+}
+
+func (m *MockWebhookRepository) UpdateDelivery(delivery entity.Delivery) error {
+	for i := range m.deliveries {
+		if m.deliveries[i].ID() == delivery.ID() {
+			m.deliveries[i] = delivery
+		}
+	}
+	return nil
+}
+
+func (m *MockWebhookRepository) FindDueDeliveries(now time.Time, limit int) ([]entity.Delivery, error) {
+	due := make([]entity.Delivery, 0)
+	for _, d := range m.deliveries {
+		if d.Status() == entity.DeliveryPending && !d.NextAttemptAt().After(now) {
+			due = append(due, d)
+		}
+	}
+	return due, nil
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newReceiver starts a webhook receiver that answers with the given status
+// codes in turn and records what it received.
+func newReceiver(t *testing.T, statuses ...int) (*httptest.Server, *[]receivedRequest) {
+	t.Helper()
+
+	received := make([]receivedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, receivedRequest{header: r.Header.Clone(), body: body})
+
+		status := statuses[min(len(received), len(statuses))-1]
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &received
+}
+
+func TestDeliver(t *testing.T) {
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Signed delivery", func(t *testing.T) {
+		server, received := newReceiver(t, http.StatusNoContent)
+		webhook, err := entity.NewWebhook(1, server.URL)
+		require.NoError(t, err)
+
+		webhooks := newMockWebhookRepository(webhook)
+		payload := []byte(`{"event":"budget.threshold_reached"}`)
+		require.NoError(t, webhooks.SaveDelivery(*entity.NewDelivery(*webhook, EventBudgetThreshold, payload, now)))
+
+		attempted, err := NewDeliverUseCase(data.Store{Webhooks: webhooks}, server.Client()).Execute(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempted)
+
+		require.Len(t, *received, 1)
+		request := (*received)[0]
+		assert.Equal(t, payload, request.body)
+		assert.Equal(t, "application/json", request.header.Get("Content-Type"))
+		assert.Equal(t, EventBudgetThreshold, request.header.Get(HeaderEvent))
+		assert.Equal(t, webhooks.deliveries[0].ID(), request.header.Get(HeaderDelivery))
+
+		timestamp, err := strconv.ParseInt(request.header.Get(HeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		assert.Equal(t, now.Unix(), timestamp)
+		assert.Equal(t, Sign(webhook.Secret(), timestamp, request.body), request.header.Get(HeaderSignature))
+
+		delivered := webhooks.deliveries[0]
+		assert.Equal(t, entity.DeliveryDelivered, delivered.Status())
+		assert.Equal(t, http.StatusNoContent, delivered.LastStatusCode())
+		assert.Equal(t, now, *delivered.DeliveredAt())
+	})
+
+	t.Run("Retried with backoff", func(t *testing.T) {
+		server, received := newReceiver(t, http.StatusInternalServerError, http.StatusOK)
+		webhook, err := entity.NewWebhook(1, server.URL)
+		require.NoError(t, err)
+
+		webhooks := newMockWebhookRepository(webhook)
+		require.NoError(t, webhooks.SaveDelivery(*entity.NewDelivery(*webhook, EventBudgetThreshold, []byte(`{}`), now)))
+
+		uc := NewDeliverUseCase(data.Store{Webhooks: webhooks}, server.Client())
+
+		_, err = uc.Execute(context.Background(), now)
+		require.NoError(t, err)
+
+		failed := webhooks.deliveries[0]
+		assert.Equal(t, entity.DeliveryPending, failed.Status())
+		assert.Equal(t, http.StatusInternalServerError, failed.LastStatusCode())
+		assert.Equal(t, "500 Internal Server Error", failed.LastError())
+		assert.Equal(t, now.Add(entity.RetryDelay(1)), *failed.NextAttemptAt())
+
+		attempted, err := uc.Execute(context.Background(), now.Add(time.Second))
+		require.NoError(t, err)
+		assert.Zero(t, attempted, "The retry should wait for the backoff")
+
+		_, err = uc.Execute(context.Background(), now.Add(entity.RetryDelay(1)))
+		require.NoError(t, err)
+
+		assert.Len(t, *received, 2)
+		assert.Equal(t, entity.DeliveryDelivered, webhooks.deliveries[0].Status())
+		assert.Equal(t, 2, webhooks.deliveries[0].Attempts())
+	})
+
+	t.Run("Unreachable receiver", func(t *testing.T) {
+		server, _ := newReceiver(t, http.StatusOK)
+		webhook, err := entity.NewWebhook(1, server.URL)
+		require.NoError(t, err)
+		server.Close()
+
+		webhooks := newMockWebhookRepository(webhook)
+		require.NoError(t, webhooks.SaveDelivery(*entity.NewDelivery(*webhook, EventBudgetThreshold, []byte(`{}`), now)))
+
+		_, err = NewDeliverUseCase(data.Store{Webhooks: webhooks}, server.Client()).Execute(context.Background(), now)
+		require.NoError(t, err)
+
+		failed := webhooks.deliveries[0]
+		assert.Equal(t, entity.DeliveryPending, failed.Status())
+		assert.Zero(t, failed.LastStatusCode())
+		assert.NotEmpty(t, failed.LastError())
+	})
+
+	t.Run("Private address", func(t *testing.T) {
+		server, received := newReceiver(t, http.StatusOK)
+		webhook, err := entity.NewWebhook(1, server.URL)
+		require.NoError(t, err)
+
+		webhooks := newMockWebhookRepository(webhook)
+		require.NoError(t, webhooks.SaveDelivery(*entity.NewDelivery(*webhook, EventBudgetThreshold, []byte(`{}`), now)))
+
+		_, err = NewDeliverUseCase(data.Store{Webhooks: webhooks}, NewWebhookClient(time.Second, false)).Execute(context.Background(), now)
+		require.NoError(t, err)
+
+		assert.Empty(t, *received, "A loopback receiver should not be reached")
+		assert.Contains(t, webhooks.deliveries[0].LastError(), ErrAddressNotAllowed.Error())
+
+		_, err = NewDeliverUseCase(data.Store{Webhooks: webhooks}, NewWebhookClient(time.Second, true)).Execute(context.Background(), now.Add(entity.RetryDelay(1)))
+		require.NoError(t, err)
+
+		assert.Len(t, *received, 1, "Private addresses should be reached when allowed")
+		assert.Equal(t, entity.DeliveryDelivered, webhooks.deliveries[0].Status())
+	})
+
+	t.Run("Inactive webhook", func(t *testing.T) {
+		server, received := newReceiver(t, http.StatusOK)
+		webhook, err := entity.NewWebhook(1, server.URL)
+		require.NoError(t, err)
+		webhook.SetActive(false)
+
+		webhooks := newMockWebhookRepository(webhook)
+		require.NoError(t, webhooks.SaveDelivery(*entity.NewDelivery(*webhook, EventBudgetThreshold, []byte(`{}`), now)))
+
+		_, err = NewDeliverUseCase(data.Store{Webhooks: webhooks}, server.Client()).Execute(context.Background(), now)
+		require.NoError(t, err)
+
+		assert.Empty(t, *received)
+		assert.Equal(t, entity.DeliveryFailed, webhooks.deliveries[0].Status())
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	message := strings.Repeat("a", maxErrorLength-1) + "é"
+
+	truncated := truncate(message)
+	assert.Equal(t, strings.Repeat("a", maxErrorLength-1), truncated, "A character should not be split")
+	assert.True(t, utf8.ValidString(truncated))
+	assert.Equal(t, "short", truncate("short"))
+}
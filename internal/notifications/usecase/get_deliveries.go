@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+var ErrInvalidDeliveryQuery = appErrors.Validation("invalid delivery query")
+
+type GetDeliveriesUseCase struct {
+	store data.Store
+}
+
+func NewGetDeliveriesUseCase(store data.Store) *GetDeliveriesUseCase {
+	return &GetDeliveriesUseCase{store: store}
+}
+
+// Execute lists the deliveries of a webhook, newest first.
+func (uc *GetDeliveriesUseCase) Execute(userID int64, webhookID string, query dto.DeliveryQueryDTO) (result *dto.DeliveryListDTO, err error) {
+	filter := data.DeliveryFilter{
+		UserID:    userID,
+		WebhookID: webhookID,
+		Status:    entity.DeliveryStatus(query.Status),
+		Limit:     DefaultPageSize,
+		Offset:    query.Offset,
+	}
+
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, fmt.Errorf("%w: status must be pending, delivered or failed", ErrInvalidDeliveryQuery)
+	}
+
+	if query.Limit < 0 || query.Limit > MaxPageSize {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidDeliveryQuery, MaxPageSize)
+	}
+	if query.Limit > 0 {
+		filter.Limit = query.Limit
+	}
+
+	if query.Offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", ErrInvalidDeliveryQuery)
+	}
+
+	if _, err := uc.store.Webhooks.FindByID(userID, webhookID); err != nil {
+		return nil, fmt.Errorf("failed to find webhook by ID: %w", err)
+	}
+
+	deliveries, err := uc.store.Webhooks.FindDeliveries(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deliveries: %w", err)
+	}
+
+	total, err := uc.store.Webhooks.CountDeliveries(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count deliveries: %w", err)
+	}
+
+	result = &dto.DeliveryListDTO{
+		Items:  make([]dto.DeliveryDTO, 0, len(deliveries)),
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+
+	for _, d := range deliveries {
+		result.Items = append(result.Items, *d.ToDTO())
+	}
+
+	return result, nil
+}
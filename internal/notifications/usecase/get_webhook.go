@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+)
+
+type GetWebhookUseCase struct {
+	store data.Store
+}
+
+func NewGetWebhookUseCase(store data.Store) *GetWebhookUseCase {
+	return &GetWebhookUseCase{store: store}
+}
+
+func (uc *GetWebhookUseCase) Execute(userID int64, id string) (result *dto.WebhookDTO, err error) {
+	webhook, err := uc.store.Webhooks.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook by ID: %w", err)
+	}
+
+	return webhook.ToDTO(), nil
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+)
+
+type GetWebhooksUseCase struct {
+	store data.Store
+}
+
+func NewGetWebhooksUseCase(store data.Store) *GetWebhooksUseCase {
+	return &GetWebhooksUseCase{store: store}
+}
+
+func (uc *GetWebhooksUseCase) Execute(userID int64) (result []dto.WebhookDTO, err error) {
+	webhooks, err := uc.store.Webhooks.FindAll(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	result = make([]dto.WebhookDTO, 0, len(webhooks))
+	for _, w := range webhooks {
+		result = append(result, *w.ToDTO())
+	}
+
+	return result, nil
+}
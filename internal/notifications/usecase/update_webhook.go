@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+)
+
+type UpdateWebhookUseCase struct {
+	store data.Store
+}
+
+func NewUpdateWebhookUseCase(store data.Store) *UpdateWebhookUseCase {
+	return &UpdateWebhookUseCase{store: store}
+}
+
+// Execute changes the webhook URL and, when given, whether it is active. The
+// secret never changes.
+func (uc *UpdateWebhookUseCase) Execute(userID int64, id string, input dto.WebhookDTO) (result *dto.WebhookDTO, err error) {
+	webhook, err := uc.store.Webhooks.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook by ID: %w", err)
+	}
+
+	if err := webhook.SetURL(input.URL); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebhook, err)
+	}
+
+	if input.Active != nil {
+		webhook.SetActive(*input.Active)
+	}
+
+	if err := uc.store.Webhooks.Update(*webhook); err != nil {
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	return webhook.ToDTO(), nil
+}
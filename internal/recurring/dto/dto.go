@@ -0,0 +1,41 @@
+package dto
+
+import "github.com/MarioGN/finance-manager-api/pkg/money"
+
+// RuleDTO describes the expense a rule creates and when it does so. Currency
+// may be left empty to use the account's or the user's base currency.
+type RuleDTO struct {
+	ID          string       `json:"id,omitempty"`
+	Amount      money.Amount `json:"amount"`
+	Currency    string       `json:"currency,omitempty"`
+	Description string       `json:"description"`
+	ExpenseType string       `json:"expense_type"`
+	CategoryID  string       `json:"category_id,omitempty"`
+	AccountID   string       `json:"account_id,omitempty"`
+	Tags        []string     `json:"tags"`
+	Frequency   string       `json:"frequency"`
+	Interval    int          `json:"interval"`
+	DayOfMonth  int          `json:"day_of_month,omitempty"`
+	StartDate   string       `json:"start_date"`
+	EndDate     string       `json:"end_date,omitempty"`
+	Count       int          `json:"count,omitempty"`
+	Paused      bool         `json:"paused"`
+}
+
+type UpcomingQueryDTO struct {
+	Days   int    `query:"days"`
+	RuleID string `query:"rule_id"`
+}
+
+type OccurrenceDTO struct {
+	RuleID      string       `json:"rule_id"`
+	Date        string       `json:"date"`
+	Description string       `json:"description"`
+	Amount      money.Amount `json:"amount"`
+	Currency    string       `json:"currency,omitempty"`
+	Skipped     bool         `json:"skipped"`
+}
+
+type SkipDTO struct {
+	Date string `json:"date"`
+}
@@ -0,0 +1,184 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Template holds the fields of the expenses a rule creates.
+type Template struct {
+	Amount      int64
+	Currency    string
+	Description string
+	ExpenseType expenseEntity.ExpenseType
+	CategoryID  string
+	AccountID   string
+	Tags        []string
+}
+
+// NewTemplate validates a template with the same rules as an expense and
+// normalizes its currency and tags.
+func NewTemplate(userID int64, amount int64, currency, description string, expenseType expenseEntity.ExpenseType, categoryID, accountID string, tags []string) (Template, error) {
+	probe, err := expenseEntity.NewExpense(userID, amount, description, time.Now(), expenseType)
+	if err != nil {
+		return Template{}, err
+	}
+
+	if err := probe.SetTags(tags); err != nil {
+		return Template{}, err
+	}
+
+	if currency != "" {
+		if currency, err = money.ParseCurrency(currency); err != nil {
+			return Template{}, err
+		}
+	}
+
+	return Template{
+		Amount:      amount,
+		Currency:    currency,
+		Description: description,
+		ExpenseType: expenseType,
+		CategoryID:  categoryID,
+		AccountID:   accountID,
+		Tags:        probe.Tags(),
+	}, nil
+}
+
+// Rule creates an expense from its template on every date of its schedule.
+// GeneratedThrough is the last date for which expenses were materialized.
+type Rule struct {
+	id               string
+	userID           int64
+	template         Template
+	schedule         Schedule
+	paused           bool
+	generatedThrough *time.Time
+}
+
+func NewRule(userID int64, template Template, schedule Schedule) (*Rule, error) {
+	if userID <= 0 {
+		return nil, errors.New("rule must belong to a user")
+	}
+
+	return &Rule{
+		id:       uuid.New().String(),
+		userID:   userID,
+		template: template,
+		schedule: schedule,
+	}, nil
+}
+
+// Due lists the dates up to today that have not been materialized yet.
+// Paused rules have none.
+func (r *Rule) Due(today time.Time) []time.Time {
+	if r.paused {
+		return nil
+	}
+
+	from := r.schedule.Start
+	if r.generatedThrough != nil {
+		from = r.generatedThrough.AddDate(0, 0, 1)
+	}
+
+	return r.schedule.Occurrences(from, today)
+}
+
+func (r *Rule) Pause() {
+	r.paused = true
+}
+
+// Resume restarts a paused rule from today. Occurrences that fell within
+// the pause are not created afterwards.
+func (r *Rule) Resume(today time.Time) {
+	if !r.paused {
+		return
+	}
+
+	r.paused = false
+
+	yesterday := today.AddDate(0, 0, -1)
+	if r.generatedThrough == nil || r.generatedThrough.Before(yesterday) {
+		r.generatedThrough = &yesterday
+	}
+}
+
+func (r *Rule) ToDTO() *dto.RuleDTO {
+	result := &dto.RuleDTO{
+		ID:          r.id,
+		Amount:      money.Amount(r.template.Amount),
+		Currency:    r.template.Currency,
+		Description: r.template.Description,
+		ExpenseType: string(r.template.ExpenseType),
+		CategoryID:  r.template.CategoryID,
+		AccountID:   r.template.AccountID,
+		Tags:        r.Tags(),
+		Frequency:   string(r.schedule.Frequency),
+		Interval:    r.schedule.Interval,
+		DayOfMonth:  r.schedule.DayOfMonth,
+		StartDate:   r.schedule.Start.Format("2006-01-02"),
+		Count:       r.schedule.Count,
+		Paused:      r.paused,
+	}
+
+	if r.schedule.End != nil {
+		result.EndDate = r.schedule.End.Format("2006-01-02")
+	}
+
+	return result
+}
+
+func (r *Rule) ID() string {
+	return r.id
+}
+
+func (r *Rule) UserID() int64 {
+	return r.userID
+}
+
+func (r *Rule) Template() Template {
+	return r.template
+}
+
+func (r *Rule) Tags() []string {
+	tags := make([]string, len(r.template.Tags))
+	copy(tags, r.template.Tags)
+	return tags
+}
+
+func (r *Rule) Schedule() Schedule {
+	return r.schedule
+}
+
+func (r *Rule) Paused() bool {
+	return r.paused
+}
+
+func (r *Rule) GeneratedThrough() *time.Time {
+	return r.generatedThrough
+}
+
+func (r *Rule) SetID(id string) {
+	r.id = id
+}
+
+func (r *Rule) SetTemplate(template Template) {
+	r.template = template
+}
+
+func (r *Rule) SetSchedule(schedule Schedule) {
+	r.schedule = schedule
+}
+
+func (r *Rule) SetPaused(paused bool) {
+	r.paused = paused
+}
+
+func (r *Rule) SetGeneratedThrough(date *time.Time) {
+	r.generatedThrough = date
+}
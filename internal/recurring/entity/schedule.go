@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Weekly  Frequency = "weekly"
+	Monthly Frequency = "monthly"
+	Yearly  Frequency = "yearly"
+)
+
+func (f Frequency) IsValid() bool {
+	switch f {
+	case Weekly, Monthly, Yearly:
+		return true
+	default:
+		return false
+	}
+}
+
+const maxInterval = 366
+
+// Schedule is a small subset of an iCalendar RRULE: every Interval weeks,
+// months or years starting on Start, until End and/or for Count
+// occurrences. Weekly schedules repeat on the weekday of Start and yearly
+// ones on its month and day. Monthly schedules repeat on DayOfMonth; months
+// that are too short use their last day instead, as do Februaries for a
+// yearly schedule starting on the 29th.
+type Schedule struct {
+	Frequency  Frequency
+	Interval   int
+	DayOfMonth int
+	Start      time.Time
+	End        *time.Time
+	Count      int
+}
+
+// NewSchedule validates a schedule. Interval defaults to 1 and a monthly
+// DayOfMonth to the day of Start.
+func NewSchedule(frequency Frequency, interval, dayOfMonth int, start time.Time, end *time.Time, count int) (Schedule, error) {
+	if !frequency.IsValid() {
+		return Schedule{}, errors.New("frequency must be weekly, monthly or yearly")
+	}
+
+	if interval == 0 {
+		interval = 1
+	}
+	if interval < 0 || interval > maxInterval {
+		return Schedule{}, errors.New("interval must be between 1 and 366")
+	}
+
+	if start.IsZero() {
+		return Schedule{}, errors.New("start date is required")
+	}
+
+	switch {
+	case frequency != Monthly && dayOfMonth != 0:
+		return Schedule{}, errors.New("day of month only applies to monthly rules")
+	case frequency == Monthly && dayOfMonth == 0:
+		dayOfMonth = start.Day()
+	case dayOfMonth < 0 || dayOfMonth > 31:
+		return Schedule{}, errors.New("day of month must be between 1 and 31")
+	}
+
+	if end != nil && end.Before(start) {
+		return Schedule{}, errors.New("end date must not be before the start date")
+	}
+
+	if count < 0 {
+		return Schedule{}, errors.New("count must not be negative")
+	}
+
+	return Schedule{
+		Frequency:  frequency,
+		Interval:   interval,
+		DayOfMonth: dayOfMonth,
+		Start:      start,
+		End:        end,
+		Count:      count,
+	}, nil
+}
+
+// Occurrences lists the dates in [from, to] on which the schedule occurs.
+func (s Schedule) Occurrences(from, to time.Time) []time.Time {
+	dates := make([]time.Time, 0)
+
+	s.each(func(date time.Time) bool {
+		if date.After(to) {
+			return false
+		}
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+		return true
+	})
+
+	return dates
+}
+
+// Next returns the first occurrence on or after date, if there is one.
+func (s Schedule) Next(date time.Time) (time.Time, bool) {
+	var (
+		next  time.Time
+		found bool
+	)
+
+	s.each(func(occurrence time.Time) bool {
+		if occurrence.Before(date) {
+			return true
+		}
+		next, found = occurrence, true
+		return false
+	})
+
+	return next, found
+}
+
+// Includes reports whether the schedule occurs on date.
+func (s Schedule) Includes(date time.Time) bool {
+	next, ok := s.Next(date)
+	return ok && next.Equal(date)
+}
+
+// each calls fn with every occurrence in order until fn returns false or
+// the schedule ends.
+func (s Schedule) each(fn func(date time.Time) bool) {
+	// A monthly day before the start day only occurs from the next period.
+	offset := 0
+	if s.nth(0).Before(s.Start) {
+		offset = 1
+	}
+
+	for n := 0; s.Count == 0 || n < s.Count; n++ {
+		date := s.nth(n + offset)
+		if s.End != nil && date.After(*s.End) {
+			return
+		}
+		if !fn(date) {
+			return
+		}
+	}
+}
+
+func (s Schedule) nth(n int) time.Time {
+	start := s.Start
+
+	switch s.Frequency {
+	case Weekly:
+		return start.AddDate(0, 0, 7*s.Interval*n)
+	case Monthly:
+		return clampedDate(start.Year(), start.Month()+time.Month(s.Interval*n), s.DayOfMonth)
+	default:
+		return clampedDate(start.Year()+s.Interval*n, start.Month(), start.Day())
+	}
+}
+
+// clampedDate builds a date, using the month's last day when day is past it.
+func clampedDate(year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+
+	return first.AddDate(0, 0, min(day, last)-1)
+}
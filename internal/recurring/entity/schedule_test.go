@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse("2006-01-02", value)
+	require.NoError(t, err)
+
+	return parsed
+}
+
+func TestSchedule_Occurrences(t *testing.T) {
+	end := date(t, "2026-04-15")
+
+	tests := []struct {
+		name       string
+		frequency  Frequency
+		interval   int
+		dayOfMonth int
+		start      string
+		end        *time.Time
+		count      int
+		expected   []string
+	}{
+		{
+			name:      "Monthly on the start day",
+			frequency: Monthly,
+			start:     "2026-01-15",
+			expected:  []string{"2026-01-15", "2026-02-15", "2026-03-15", "2026-04-15", "2026-05-15", "2026-06-15"},
+		},
+		{
+			name:       "Monthly on the 31st uses the last day of shorter months",
+			frequency:  Monthly,
+			dayOfMonth: 31,
+			start:      "2026-01-01",
+			expected:   []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31", "2026-06-30"},
+		},
+		{
+			name:       "Monthly on a day before the start day begins next month",
+			frequency:  Monthly,
+			dayOfMonth: 1,
+			start:      "2026-01-15",
+			count:      2,
+			expected:   []string{"2026-02-01", "2026-03-01"},
+		},
+		{
+			name:      "Every other week until the end date",
+			frequency: Weekly,
+			interval:  2,
+			start:     "2026-03-02",
+			end:       &end,
+			expected:  []string{"2026-03-02", "2026-03-16", "2026-03-30", "2026-04-13"},
+		},
+		{
+			name:      "Quarterly for three occurrences",
+			frequency: Monthly,
+			interval:  3,
+			start:     "2026-01-10",
+			count:     3,
+			expected:  []string{"2026-01-10", "2026-04-10"},
+		},
+		{
+			name:      "Yearly on a leap day",
+			frequency: Yearly,
+			start:     "2024-02-29",
+			expected:  []string{"2026-02-28"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := NewSchedule(tt.frequency, tt.interval, tt.dayOfMonth, date(t, tt.start), tt.end, tt.count)
+			require.NoError(t, err)
+
+			occurrences := schedule.Occurrences(date(t, "2026-01-01"), date(t, "2026-06-30"))
+
+			dates := make([]string, 0, len(occurrences))
+			for _, o := range occurrences {
+				dates = append(dates, o.Format("2006-01-02"))
+			}
+			assert.Equal(t, tt.expected, dates)
+		})
+	}
+}
+
+func TestSchedule_NextAndIncludes(t *testing.T) {
+	schedule, err := NewSchedule(Monthly, 1, 31, date(t, "2026-01-31"), nil, 3)
+	require.NoError(t, err)
+
+	next, ok := schedule.Next(date(t, "2026-02-01"))
+	require.True(t, ok)
+	assert.Equal(t, date(t, "2026-02-28"), next)
+
+	assert.True(t, schedule.Includes(date(t, "2026-03-31")))
+	assert.False(t, schedule.Includes(date(t, "2026-03-30")))
+
+	_, ok = schedule.Next(date(t, "2026-04-01"))
+	assert.False(t, ok, "The schedule should end after its count")
+}
+
+func TestNewSchedule_Invalid(t *testing.T) {
+	start := date(t, "2026-01-15")
+	before := date(t, "2026-01-01")
+
+	tests := []struct {
+		name       string
+		frequency  Frequency
+		interval   int
+		dayOfMonth int
+		end        *time.Time
+		count      int
+	}{
+		{name: "Unknown frequency", frequency: "daily"},
+		{name: "Negative interval", frequency: Weekly, interval: -1},
+		{name: "Day of month on a weekly rule", frequency: Weekly, dayOfMonth: 3},
+		{name: "Day of month out of range", frequency: Monthly, dayOfMonth: 32},
+		{name: "End before start", frequency: Monthly, end: &before},
+		{name: "Negative count", frequency: Monthly, count: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSchedule(tt.frequency, tt.interval, tt.dayOfMonth, start, tt.end, tt.count)
+			assert.Error(t, err)
+		})
+	}
+}
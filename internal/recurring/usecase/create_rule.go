@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidRule = appErrors.Validation("invalid recurring rule")
+
+// now is replaced in tests.
+var now = time.Now
+
+// today returns the current date in UTC, the zone every stored date uses.
+func today() time.Time {
+	y, m, d := now().UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+type CreateRuleUseCase struct {
+	store data.Store
+}
+
+func NewCreateRuleUseCase(store data.Store) *CreateRuleUseCase {
+	return &CreateRuleUseCase{store: store}
+}
+
+// Execute creates a rule. Occurrences between its start date and today are
+// created by the next scheduler run.
+func (uc *CreateRuleUseCase) Execute(userID int64, input dto.RuleDTO) (result *dto.RuleDTO, err error) {
+	template, schedule, err := parseRule(uc.store, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := entity.NewRule(userID, template, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+	}
+
+	if err := uc.store.Recurring.Save(*rule); err != nil {
+		return nil, fmt.Errorf("failed to save recurring rule: %w", err)
+	}
+
+	return rule.ToDTO(), nil
+}
+
+// parseRule validates the expense template and schedule of a rule and
+// checks that its category and account belong to the user.
+func parseRule(store data.Store, userID int64, input dto.RuleDTO) (entity.Template, entity.Schedule, error) {
+	template, err := entity.NewTemplate(userID, int64(input.Amount), input.Currency, input.Description,
+		expenseEntity.ExpenseType(input.ExpenseType), input.CategoryID, input.AccountID, input.Tags)
+	if err != nil {
+		return entity.Template{}, entity.Schedule{}, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+	}
+
+	start, err := time.Parse("2006-01-02", input.StartDate)
+	if err != nil {
+		return entity.Template{}, entity.Schedule{}, fmt.Errorf("%w: start_date must be a YYYY-MM-DD date", ErrInvalidRule)
+	}
+
+	var end *time.Time
+	if input.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", input.EndDate)
+		if err != nil {
+			return entity.Template{}, entity.Schedule{}, fmt.Errorf("%w: end_date must be a YYYY-MM-DD date", ErrInvalidRule)
+		}
+		end = &parsed
+	}
+
+	schedule, err := entity.NewSchedule(entity.Frequency(input.Frequency), input.Interval, input.DayOfMonth, start, end, input.Count)
+	if err != nil {
+		return entity.Template{}, entity.Schedule{}, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+	}
+
+	if template.CategoryID != "" {
+		_, err := store.Categories.FindByID(userID, template.CategoryID)
+		if errors.Is(err, data.ErrCategoryNotFound) {
+			return entity.Template{}, entity.Schedule{}, fmt.Errorf("%w: category %s does not exist", ErrInvalidRule, template.CategoryID)
+		}
+		if err != nil {
+			return entity.Template{}, entity.Schedule{}, fmt.Errorf("failed to find category: %w", err)
+		}
+	}
+
+	if template.AccountID != "" {
+		account, err := store.Accounts.FindByID(userID, template.AccountID)
+		if errors.Is(err, data.ErrAccountNotFound) {
+			return entity.Template{}, entity.Schedule{}, fmt.Errorf("%w: account %s does not exist", ErrInvalidRule, template.AccountID)
+		}
+		if err != nil {
+			return entity.Template{}, entity.Schedule{}, fmt.Errorf("failed to find account: %w", err)
+		}
+		if template.Currency != "" && template.Currency != account.Currency() {
+			return entity.Template{}, entity.Schedule{}, fmt.Errorf("%w: account %s is kept in %s", ErrInvalidRule, account.Name(), account.Currency())
+		}
+	}
+
+	return template, schedule, nil
+}
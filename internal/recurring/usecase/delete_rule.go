@@ -0,0 +1,15 @@
+package usecase
+
+import "github.com/MarioGN/finance-manager-api/data"
+
+type DeleteRuleUseCase struct {
+	store data.Store
+}
+
+func NewDeleteRuleUseCase(store data.Store) *DeleteRuleUseCase {
+	return &DeleteRuleUseCase{store: store}
+}
+
+func (uc *DeleteRuleUseCase) Execute(userID int64, id string) error {
+	return uc.store.Recurring.Delete(userID, id)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+)
+
+type GetRuleUseCase struct {
+	store data.Store
+}
+
+func NewGetRuleUseCase(store data.Store) *GetRuleUseCase {
+	return &GetRuleUseCase{store: store}
+}
+
+func (uc *GetRuleUseCase) Execute(userID int64, id string) (*dto.RuleDTO, error) {
+	rule, err := uc.store.Recurring.FindByID(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule.ToDTO(), nil
+}
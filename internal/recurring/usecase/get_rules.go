@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+)
+
+type GetRulesUseCase struct {
+	store data.Store
+}
+
+func NewGetRulesUseCase(store data.Store) *GetRulesUseCase {
+	return &GetRulesUseCase{store: store}
+}
+
+func (uc *GetRulesUseCase) Execute(userID int64) (result []dto.RuleDTO, err error) {
+	rules, err := uc.store.Recurring.FindAll(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring rules: %w", err)
+	}
+
+	result = make([]dto.RuleDTO, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, *r.ToDTO())
+	}
+
+	return result, nil
+}
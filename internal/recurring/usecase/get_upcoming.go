@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/entity"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+const (
+	DefaultUpcomingDays = 30
+	MaxUpcomingDays     = 366
+)
+
+type GetUpcomingUseCase struct {
+	store data.Store
+}
+
+func NewGetUpcomingUseCase(store data.Store) *GetUpcomingUseCase {
+	return &GetUpcomingUseCase{store: store}
+}
+
+// Execute lists the occurrences of the user's active rules from today on,
+// in date order. Skipped dates are included and flagged.
+func (uc *GetUpcomingUseCase) Execute(userID int64, query dto.UpcomingQueryDTO) (result []dto.OccurrenceDTO, err error) {
+	days := query.Days
+	if days == 0 {
+		days = DefaultUpcomingDays
+	}
+	if days < 1 || days > MaxUpcomingDays {
+		return nil, fmt.Errorf("%w: days must be between 1 and %d", ErrInvalidRule, MaxUpcomingDays)
+	}
+
+	var rules []entity.Rule
+	if query.RuleID != "" {
+		rule, err := uc.store.Recurring.FindByID(userID, query.RuleID)
+		if err != nil {
+			return nil, err
+		}
+		rules = []entity.Rule{*rule}
+	} else if rules, err = uc.store.Recurring.FindAll(userID); err != nil {
+		return nil, fmt.Errorf("failed to list recurring rules: %w", err)
+	}
+
+	from := today()
+	to := from.AddDate(0, 0, days-1)
+	result = make([]dto.OccurrenceDTO, 0)
+
+	for _, rule := range rules {
+		if rule.Paused() {
+			continue
+		}
+
+		start := from
+		if through := rule.GeneratedThrough(); through != nil && !through.Before(start) {
+			start = through.AddDate(0, 0, 1)
+		}
+
+		recorded, err := uc.store.Recurring.Occurrences(rule.ID(), start, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list occurrences: %w", err)
+		}
+
+		skipped := make(map[string]bool, len(recorded))
+		for _, o := range recorded {
+			skipped[o.Date.Format("2006-01-02")] = o.Status == data.OccurrenceSkipped
+		}
+
+		template := rule.Template()
+		for _, date := range rule.Schedule().Occurrences(start, to) {
+			key := date.Format("2006-01-02")
+			result = append(result, dto.OccurrenceDTO{
+				RuleID:      rule.ID(),
+				Date:        key,
+				Description: template.Description,
+				Amount:      money.Amount(template.Amount),
+				Currency:    template.Currency,
+				Skipped:     skipped[key],
+			})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Date < result[j].Date
+	})
+
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	expenseDTO "github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	expenseUseCase "github.com/MarioGN/finance-manager-api/internal/expenses/usecase"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/entity"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+type MaterializeUseCase struct {
+	store data.Store
+}
+
+func NewMaterializeUseCase(store data.Store) *MaterializeUseCase {
+	return &MaterializeUseCase{store: store}
+}
+
+// Execute creates the expenses of every user's rules that are due on or
+// before the given day and returns how many were created. Each date is
+// claimed before its expense is created, so running it again, or twice at
+// once, never creates an expense twice. A rule whose expense cannot be
+// created is retried on the next run, unless the rule itself is invalid,
+// such as one for a category that no longer exists: that rule is paused
+// until the user fixes and resumes it. The other rules are not held up.
+func (uc *MaterializeUseCase) Execute(day time.Time) (created int, err error) {
+	y, m, d := day.UTC().Date()
+	day = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	rules, err := uc.store.Recurring.FindDue(day)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find due recurring rules: %w", err)
+	}
+
+	var errs []error
+
+	for _, rule := range rules {
+		n, err := uc.materialize(rule, day)
+		created += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %s: %w", rule.ID(), err))
+		}
+	}
+
+	return created, errors.Join(errs...)
+}
+
+func (uc *MaterializeUseCase) materialize(rule entity.Rule, day time.Time) (created int, err error) {
+	createExpense := expenseUseCase.NewCreateExpenseUseCase(uc.store)
+	template := rule.Template()
+
+	for _, date := range rule.Due(day) {
+		err := uc.store.Recurring.ClaimOccurrence(data.Occurrence{RuleID: rule.ID(), Date: date, Status: data.OccurrenceCreated})
+		if errors.Is(err, data.ErrOccurrenceTaken) {
+			continue
+		}
+		if err != nil {
+			return created, fmt.Errorf("failed to claim %s: %w", date.Format("2006-01-02"), err)
+		}
+
+		expense, err := createExpense.Execute(rule.UserID(), expenseDTO.ExpenseDTO{
+			Amount:      money.Amount(template.Amount),
+			Currency:    template.Currency,
+			Description: template.Description,
+			Date:        date.Format("2006-01-02"),
+			ExpenseType: string(template.ExpenseType),
+			CategoryID:  template.CategoryID,
+			AccountID:   template.AccountID,
+			Tags:        rule.Tags(),
+		})
+		if err != nil {
+			err = errors.Join(
+				fmt.Errorf("failed to create expense for %s: %w", date.Format("2006-01-02"), err),
+				uc.store.Recurring.ReleaseOccurrence(rule.ID(), date),
+			)
+			if appErrors.KindOf(err) == appErrors.KindValidation {
+				// The same expense would be refused on every run.
+				rule.Pause()
+				err = errors.Join(fmt.Errorf("rule paused: %w", err), uc.store.Recurring.Update(rule))
+			}
+			return created, err
+		}
+
+		if err := uc.store.Recurring.SetOccurrenceExpense(rule.ID(), date, expense.ID); err != nil {
+			return created, fmt.Errorf("failed to record expense for %s: %w", date.Format("2006-01-02"), err)
+		}
+
+		created++
+	}
+
+	if err := uc.store.Recurring.SetGeneratedThrough(rule.ID(), day); err != nil {
+		return created, fmt.Errorf("failed to advance rule: %w", err)
+	}
+
+	return created, nil
+}
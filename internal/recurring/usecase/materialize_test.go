@@ -0,0 +1,285 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	budgetEntity "github.com/MarioGN/finance-manager-api/internal/budgets/entity"
+	categoryEntity "github.com/MarioGN/finance-manager-api/internal/categories/entity"
+	expenseEntity "github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRecurringRuleRepository implements data.RecurringRuleRepository in
+// memory for testing
+type MockRecurringRuleRepository struct {
+	data.RecurringRuleRepository
+
+	rules       map[string]entity.Rule
+	occurrences map[string]data.Occurrence
+}
+
+func newMockRecurringRuleRepository(rules ...*entity.Rule) *MockRecurringRuleRepository {
+	m := &MockRecurringRuleRepository{rules: map[string]entity.Rule{}, occurrences: map[string]data.Occurrence{}}
+	for _, r := range rules {
+		m.rules[r.ID()] = *r
+	}
+	return m
+}
+
+func occurrenceKey(ruleID string, date time.Time) string {
+	return ruleID + "/" + date.Format("2006-01-02")
+}
+
+func (m *MockRecurringRuleRepository) FindByID(userID int64, id string) (*entity.Rule, error) {
+	r, ok := m.rules[id]
+	if !ok || r.UserID() != userID {
+		return nil, fmt.Errorf("%w: %s", data.ErrRuleNotFound, id)
+	}
+	return &r, nil
+}
+
+func (m *MockRecurringRuleRepository) FindAll(userID int64) ([]entity.Rule, error) {
+	rules := make([]entity.Rule, 0)
+	for _, r := range m.rules {
+		if r.UserID() == userID {
+			rules = append(rules, r)
+		}
+	}
+	return rules, nil
+}
+
+func (m *MockRecurringRuleRepository) Update(rule entity.Rule) error {
+	m.rules[rule.ID()] = rule
+	return nil
+}
+
+func (m *MockRecurringRuleRepository) Occurrences(ruleID string, from, to time.Time) ([]data.Occurrence, error) {
+	occurrences := make([]data.Occurrence, 0)
+	for _, o := range m.occurrences {
+		if o.RuleID == ruleID && !o.Date.Before(from) && !o.Date.After(to) {
+			occurrences = append(occurrences, o)
+		}
+	}
+	return occurrences, nil
+}
+
+func (m *MockRecurringRuleRepository) FindDue(date time.Time) ([]entity.Rule, error) {
+	rules := make([]entity.Rule, 0)
+	for _, r := range m.rules {
+		if !r.Paused() {
+			rules = append(rules, r)
+		}
+	}
+	return rules, nil
+}
+
+func (m *MockRecurringRuleRepository) SetGeneratedThrough(ruleID string, date time.Time) error {
+	r := m.rules[ruleID]
+	r.SetGeneratedThrough(&date)
+	m.rules[ruleID] = r
+	return nil
+}
+
+func (m *MockRecurringRuleRepository) ClaimOccurrence(occurrence data.Occurrence) error {
+	key := occurrenceKey(occurrence.RuleID, occurrence.Date)
+	if _, ok := m.occurrences[key]; ok {
+		return data.ErrOccurrenceTaken
+	}
+	m.occurrences[key] = occurrence
+	return nil
+}
+
+func (m *MockRecurringRuleRepository) SetOccurrenceExpense(ruleID string, date time.Time, expenseID string) error {
+	key := occurrenceKey(ruleID, date)
+	o := m.occurrences[key]
+	o.ExpenseID = expenseID
+	m.occurrences[key] = o
+	return nil
+}
+
+func (m *MockRecurringRuleRepository) ReleaseOccurrence(ruleID string, date time.Time) error {
+	delete(m.occurrences, occurrenceKey(ruleID, date))
+	return nil
+}
+
+// MockBudgetRepository has no budgets, so created expenses raise no alerts
+type MockBudgetRepository struct {
+	data.BudgetRepository
+}
+
+func (m *MockBudgetRepository) FindAll(userID int64) ([]budgetEntity.Budget, error) {
+	return []budgetEntity.Budget{}, nil
+}
+
+// MockExpenseRepository records saved expenses and fails when err is set
+type MockExpenseRepository struct {
+	data.ExpenseRepository
+
+	saved []expenseEntity.Expense
+	err   error
+}
+
+func (m *MockExpenseRepository) Save(expense expenseEntity.Expense) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.saved = append(m.saved, expense)
+	return nil
+}
+
+// MockCategoryRepository has no categories
+type MockCategoryRepository struct {
+	data.CategoryRepository
+}
+
+func (m *MockCategoryRepository) FindByID(userID int64, id string) (*categoryEntity.Category, error) {
+	return nil, fmt.Errorf("%w: %s", data.ErrCategoryNotFound, id)
+}
+
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse("2006-01-02", value)
+	require.NoError(t, err)
+
+	return parsed
+}
+
+func savedDates(expenses []expenseEntity.Expense) []string {
+	dates := make([]string, 0, len(expenses))
+	for _, e := range expenses {
+		dates = append(dates, e.Date().Format("2006-01-02"))
+	}
+	return dates
+}
+
+func TestMaterialize(t *testing.T) {
+	template, err := entity.NewTemplate(1, 120000, "EUR", "Rent", expenseEntity.FixedExpense, "", "", []string{"home"})
+	require.NoError(t, err)
+	schedule, err := entity.NewSchedule(entity.Monthly, 1, 31, date(t, "2026-01-31"), nil, 0)
+	require.NoError(t, err)
+	rule, err := entity.NewRule(1, template, schedule)
+	require.NoError(t, err)
+
+	rules := newMockRecurringRuleRepository(rule)
+	expenses := &MockExpenseRepository{}
+	store := data.Store{Recurring: rules, Expenses: expenses, Budgets: &MockBudgetRepository{}}
+	uc := NewMaterializeUseCase(store)
+
+	created, err := uc.Execute(date(t, "2026-03-30"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, created)
+	assert.Equal(t, []string{"2026-01-31", "2026-02-28"}, savedDates(expenses.saved))
+	assert.Equal(t, []string{"home"}, expenses.saved[0].Tags())
+	assert.Equal(t, expenseEntity.FixedExpense, expenses.saved[0].ExpenseType())
+
+	created, err = uc.Execute(date(t, "2026-03-30"))
+	require.NoError(t, err)
+	assert.Zero(t, created, "Running again on the same day should not create anything")
+
+	// A rule whose progress was lost still creates nothing twice.
+	rules.rules[rule.ID()] = *rule
+	created, err = uc.Execute(date(t, "2026-03-31"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	assert.Equal(t, []string{"2026-01-31", "2026-02-28", "2026-03-31"}, savedDates(expenses.saved))
+
+	for _, e := range expenses.saved {
+		o := rules.occurrences[occurrenceKey(rule.ID(), e.Date())]
+		assert.Equal(t, e.ID(), o.ExpenseID, "Occurrences should point at their expense")
+	}
+}
+
+func TestMaterialize_SkipPauseAndFailures(t *testing.T) {
+	template, err := entity.NewTemplate(1, 120000, "EUR", "Rent", expenseEntity.FixedExpense, "", "", nil)
+	require.NoError(t, err)
+	schedule, err := entity.NewSchedule(entity.Monthly, 1, 0, date(t, "2026-01-10"), nil, 0)
+	require.NoError(t, err)
+	rule, err := entity.NewRule(1, template, schedule)
+	require.NoError(t, err)
+
+	rules := newMockRecurringRuleRepository(rule)
+	expenses := &MockExpenseRepository{}
+	store := data.Store{Recurring: rules, Expenses: expenses, Budgets: &MockBudgetRepository{}}
+
+	defer func() { now = time.Now }()
+	now = func() time.Time { return date(t, "2026-01-05") }
+
+	skip := NewSkipOccurrenceUseCase(store)
+	require.NoError(t, skip.Execute(1, rule.ID(), dto.SkipDTO{Date: "2026-02-10"}))
+	assert.ErrorIs(t, skip.Execute(1, rule.ID(), dto.SkipDTO{Date: "2026-02-10"}), data.ErrOccurrenceTaken)
+	assert.ErrorIs(t, skip.Execute(1, rule.ID(), dto.SkipDTO{Date: "2026-02-11"}), ErrInvalidSkip)
+	assert.ErrorIs(t, skip.Execute(2, rule.ID(), dto.SkipDTO{Date: "2026-03-10"}), data.ErrRuleNotFound)
+
+	upcoming, err := NewGetUpcomingUseCase(store).Execute(1, dto.UpcomingQueryDTO{Days: 60})
+	require.NoError(t, err)
+	require.Len(t, upcoming, 2)
+	assert.Equal(t, "2026-01-10", upcoming[0].Date)
+	assert.False(t, upcoming[0].Skipped)
+	assert.Equal(t, "2026-02-10", upcoming[1].Date)
+	assert.True(t, upcoming[1].Skipped)
+
+	uc := NewMaterializeUseCase(store)
+
+	expenses.err = errors.New("disk full")
+	created, err := uc.Execute(date(t, "2026-01-10"))
+	assert.Error(t, err)
+	assert.Zero(t, created)
+	assert.Empty(t, rules.occurrences[occurrenceKey(rule.ID(), date(t, "2026-01-10"))], "A failed date should be released for the next run")
+
+	expenses.err = nil
+	created, err = uc.Execute(date(t, "2026-02-28"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, created, "The skipped date should not be created")
+	assert.Equal(t, []string{"2026-01-10"}, savedDates(expenses.saved))
+
+	pause := NewPauseRuleUseCase(store)
+	_, err = pause.Execute(1, rule.ID(), true)
+	require.NoError(t, err)
+
+	created, err = uc.Execute(date(t, "2026-04-30"))
+	require.NoError(t, err)
+	assert.Zero(t, created, "Paused rules should not create expenses")
+
+	now = func() time.Time { return date(t, "2026-04-10") }
+	_, err = pause.Execute(1, rule.ID(), false)
+	require.NoError(t, err)
+
+	created, err = uc.Execute(date(t, "2026-05-10"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, created)
+	assert.Equal(t, []string{"2026-01-10", "2026-04-10", "2026-05-10"}, savedDates(expenses.saved),
+		"Dates during the pause should not be caught up on")
+}
+
+func TestMaterialize_PausesInvalidRules(t *testing.T) {
+	template, err := entity.NewTemplate(1, 4500, "EUR", "Gym", expenseEntity.FixedExpense, "deleted-category", "", nil)
+	require.NoError(t, err)
+	schedule, err := entity.NewSchedule(entity.Weekly, 1, 0, date(t, "2026-03-02"), nil, 0)
+	require.NoError(t, err)
+	rule, err := entity.NewRule(1, template, schedule)
+	require.NoError(t, err)
+
+	rules := newMockRecurringRuleRepository(rule)
+	expenses := &MockExpenseRepository{}
+	store := data.Store{Recurring: rules, Expenses: expenses, Categories: &MockCategoryRepository{}, Budgets: &MockBudgetRepository{}}
+	uc := NewMaterializeUseCase(store)
+
+	created, err := uc.Execute(date(t, "2026-03-10"))
+	assert.Error(t, err)
+	assert.Zero(t, created)
+	paused := rules.rules[rule.ID()]
+	assert.True(t, paused.Paused(), "A rule whose expense can never be created should be paused")
+	assert.Empty(t, rules.occurrences, "The failed date should be released")
+
+	created, err = uc.Execute(date(t, "2026-03-17"))
+	require.NoError(t, err, "A paused rule should not be retried")
+	assert.Zero(t, created)
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+)
+
+type PauseRuleUseCase struct {
+	store data.Store
+}
+
+func NewPauseRuleUseCase(store data.Store) *PauseRuleUseCase {
+	return &PauseRuleUseCase{store: store}
+}
+
+// Execute pauses a rule when pause is true and resumes it otherwise. A
+// resumed rule continues from today; it does not catch up on the dates it
+// was paused for.
+func (uc *PauseRuleUseCase) Execute(userID int64, id string, pause bool) (result *dto.RuleDTO, err error) {
+	rule, err := uc.store.Recurring.FindByID(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if pause {
+		rule.Pause()
+	} else {
+		rule.Resume(today())
+	}
+
+	if err := uc.store.Recurring.Update(*rule); err != nil {
+		return nil, fmt.Errorf("failed to save recurring rule: %w", err)
+	}
+
+	return rule.ToDTO(), nil
+}
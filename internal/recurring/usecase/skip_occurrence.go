@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidSkip = appErrors.Validation("invalid occurrence")
+
+type SkipOccurrenceUseCase struct {
+	store data.Store
+}
+
+func NewSkipOccurrenceUseCase(store data.Store) *SkipOccurrenceUseCase {
+	return &SkipOccurrenceUseCase{store: store}
+}
+
+// Execute keeps a rule from creating the expense of one upcoming date.
+func (uc *SkipOccurrenceUseCase) Execute(userID int64, ruleID string, input dto.SkipDTO) error {
+	rule, err := uc.store.Recurring.FindByID(userID, ruleID)
+	if err != nil {
+		return err
+	}
+
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		return fmt.Errorf("%w: date must be a YYYY-MM-DD date", ErrInvalidSkip)
+	}
+
+	if !rule.Schedule().Includes(date) {
+		return fmt.Errorf("%w: the rule does not occur on %s", ErrInvalidSkip, input.Date)
+	}
+
+	if through := rule.GeneratedThrough(); through != nil && !date.After(*through) {
+		return fmt.Errorf("%w: %s", data.ErrOccurrenceTaken, input.Date)
+	}
+
+	return uc.store.Recurring.ClaimOccurrence(data.Occurrence{RuleID: rule.ID(), Date: date, Status: data.OccurrenceSkipped})
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+)
+
+type UpdateRuleUseCase struct {
+	store data.Store
+}
+
+func NewUpdateRuleUseCase(store data.Store) *UpdateRuleUseCase {
+	return &UpdateRuleUseCase{store: store}
+}
+
+// Execute replaces the template and schedule of a rule. Expenses already
+// created from it are left as they are, and it stays paused if it was.
+func (uc *UpdateRuleUseCase) Execute(userID int64, id string, input dto.RuleDTO) (result *dto.RuleDTO, err error) {
+	rule, err := uc.store.Recurring.FindByID(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	template, schedule, err := parseRule(uc.store, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.SetTemplate(template)
+	rule.SetSchedule(schedule)
+
+	if err := uc.store.Recurring.Update(*rule); err != nil {
+		return nil, fmt.Errorf("failed to save recurring rule: %w", err)
+	}
+
+	return rule.ToDTO(), nil
+}
@@ -0,0 +1,61 @@
+package dto
+
+import "github.com/MarioGN/finance-manager-api/pkg/money"
+
+type SummaryQueryDTO struct {
+	From     string `query:"from"`
+	To       string `query:"to"`
+	GroupBy  string `query:"group_by"`
+	Currency string `query:"currency"`
+}
+
+type SummaryGroupDTO struct {
+	Key     string       `json:"key"`
+	Total   money.Amount `json:"total"`
+	Count   int64        `json:"count"`
+	Average money.Amount `json:"average"`
+}
+
+type SummaryTotalsDTO struct {
+	Total   money.Amount `json:"total"`
+	Count   int64        `json:"count"`
+	Average money.Amount `json:"average"`
+}
+
+type SummaryDTO struct {
+	From     string            `json:"from,omitempty"`
+	To       string            `json:"to,omitempty"`
+	GroupBy  string            `json:"group_by"`
+	Currency string            `json:"currency"`
+	Totals   SummaryTotalsDTO  `json:"totals"`
+	Groups   []SummaryGroupDTO `json:"groups"`
+}
+
+type CashflowQueryDTO struct {
+	From     string `query:"from"`
+	To       string `query:"to"`
+	GroupBy  string `query:"group_by"`
+	Currency string `query:"currency"`
+}
+
+type CashflowPeriodDTO struct {
+	Key      string       `json:"key"`
+	Income   money.Amount `json:"income"`
+	Expenses money.Amount `json:"expenses"`
+	Net      money.Amount `json:"net"`
+}
+
+type CashflowTotalsDTO struct {
+	Income   money.Amount `json:"income"`
+	Expenses money.Amount `json:"expenses"`
+	Net      money.Amount `json:"net"`
+}
+
+type CashflowDTO struct {
+	From     string              `json:"from,omitempty"`
+	To       string              `json:"to,omitempty"`
+	GroupBy  string              `json:"group_by"`
+	Currency string              `json:"currency"`
+	Totals   CashflowTotalsDTO   `json:"totals"`
+	Periods  []CashflowPeriodDTO `json:"periods"`
+}
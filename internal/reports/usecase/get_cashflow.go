@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	exchangeRates "github.com/MarioGN/finance-manager-api/internal/exchangerates/usecase"
+	"github.com/MarioGN/finance-manager-api/internal/reports/dto"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+type GetCashflowUseCase struct {
+	store data.Store
+}
+
+func NewGetCashflowUseCase(store data.Store) *GetCashflowUseCase {
+	return &GetCashflowUseCase{store: store}
+}
+
+// Execute nets the user's incomes against their expenses per month or ISO
+// week. Periods without any income or expense are omitted.
+func (uc *GetCashflowUseCase) Execute(userID int64, query dto.CashflowQueryDTO) (result *dto.CashflowDTO, err error) {
+	filter := data.SummaryFilter{UserID: userID, GroupBy: data.GroupByMonth}
+
+	switch data.SummaryGrouping(query.GroupBy) {
+	case "":
+	case data.GroupByMonth, data.GroupByWeek:
+		filter.GroupBy = data.SummaryGrouping(query.GroupBy)
+	default:
+		return nil, fmt.Errorf("%w: group_by must be month or week", ErrInvalidReportQuery)
+	}
+
+	if filter.From, err = parseOptionalDate(query.From); err != nil {
+		return nil, fmt.Errorf("%w: from must be a YYYY-MM-DD date", ErrInvalidReportQuery)
+	}
+
+	if filter.To, err = parseOptionalDate(query.To); err != nil {
+		return nil, fmt.Errorf("%w: to must be a YYYY-MM-DD date", ErrInvalidReportQuery)
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, fmt.Errorf("%w: from must not be after to", ErrInvalidReportQuery)
+	}
+
+	currency, err := reportCurrency(uc.store, userID, query.Currency)
+	if err != nil {
+		return nil, err
+	}
+	filter.Converter = exchangeRates.NewConverter(uc.store, userID, currency)
+
+	cashflow, err := uc.store.Reports.SummarizeCashflow(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize cash flow: %w", err)
+	}
+
+	result = &dto.CashflowDTO{
+		From:     query.From,
+		To:       query.To,
+		GroupBy:  string(filter.GroupBy),
+		Currency: currency,
+		Totals: dto.CashflowTotalsDTO{
+			Income:   money.Amount(cashflow.Totals.Income),
+			Expenses: money.Amount(cashflow.Totals.Expenses),
+			Net:      money.Amount(cashflow.Totals.Net()),
+		},
+		Periods: make([]dto.CashflowPeriodDTO, 0, len(cashflow.Periods)),
+	}
+
+	for _, p := range cashflow.Periods {
+		result.Periods = append(result.Periods, dto.CashflowPeriodDTO{
+			Key:      p.Key,
+			Income:   money.Amount(p.Income),
+			Expenses: money.Amount(p.Expenses),
+			Net:      money.Amount(p.Net()),
+		})
+	}
+
+	return result, nil
+}
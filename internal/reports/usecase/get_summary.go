@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	exchangeRates "github.com/MarioGN/finance-manager-api/internal/exchangerates/usecase"
+	"github.com/MarioGN/finance-manager-api/internal/reports/dto"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+var ErrInvalidReportQuery = appErrors.Validation("invalid report query")
+
+type GetSummaryUseCase struct {
+	store data.Store
+}
+
+func NewGetSummaryUseCase(store data.Store) *GetSummaryUseCase {
+	return &GetSummaryUseCase{store: store}
+}
+
+func (uc *GetSummaryUseCase) Execute(userID int64, query dto.SummaryQueryDTO) (result *dto.SummaryDTO, err error) {
+	filter := data.SummaryFilter{UserID: userID, GroupBy: data.GroupByMonth}
+
+	if query.GroupBy != "" {
+		filter.GroupBy = data.SummaryGrouping(query.GroupBy)
+		if !filter.GroupBy.IsValid() {
+			return nil, fmt.Errorf("%w: group_by must be month, week or type", ErrInvalidReportQuery)
+		}
+	}
+
+	if filter.From, err = parseOptionalDate(query.From); err != nil {
+		return nil, fmt.Errorf("%w: from must be a YYYY-MM-DD date", ErrInvalidReportQuery)
+	}
+
+	if filter.To, err = parseOptionalDate(query.To); err != nil {
+		return nil, fmt.Errorf("%w: to must be a YYYY-MM-DD date", ErrInvalidReportQuery)
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, fmt.Errorf("%w: from must not be after to", ErrInvalidReportQuery)
+	}
+
+	currency, err := reportCurrency(uc.store, userID, query.Currency)
+	if err != nil {
+		return nil, err
+	}
+	filter.Converter = exchangeRates.NewConverter(uc.store, userID, currency)
+
+	summary, err := uc.store.Reports.SummarizeExpenses(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize expenses: %w", err)
+	}
+
+	result = &dto.SummaryDTO{
+		From:     query.From,
+		To:       query.To,
+		GroupBy:  string(filter.GroupBy),
+		Currency: currency,
+		Totals: dto.SummaryTotalsDTO{
+			Total:   money.Amount(summary.Totals.Total),
+			Count:   summary.Totals.Count,
+			Average: money.Amount(summary.Totals.Average),
+		},
+		Groups: make([]dto.SummaryGroupDTO, 0, len(summary.Groups)),
+	}
+
+	for _, g := range summary.Groups {
+		result.Groups = append(result.Groups, dto.SummaryGroupDTO{
+			Key:     g.Key,
+			Total:   money.Amount(g.Total),
+			Count:   g.Count,
+			Average: money.Amount(g.Average),
+		})
+	}
+
+	return result, nil
+}
+
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
+
+// reportCurrency validates the requested report currency, falling back to
+// the user's base currency.
+func reportCurrency(store data.Store, userID int64, requested string) (string, error) {
+	if requested != "" {
+		currency, err := money.ParseCurrency(requested)
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", ErrInvalidReportQuery, err)
+		}
+		return currency, nil
+	}
+
+	user, err := store.Users.FindByID(userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return user.BaseCurrency(), nil
+}
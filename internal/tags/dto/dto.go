@@ -0,0 +1,15 @@
+package dto
+
+type TagDTO struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	UsageCount int64  `json:"usage_count"`
+}
+
+type RenameTagDTO struct {
+	Name string `json:"name"`
+}
+
+type MergeTagDTO struct {
+	Into string `json:"into"`
+}
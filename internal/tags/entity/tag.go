@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/internal/tags/dto"
+	"github.com/google/uuid"
+)
+
+const maxNameLength = 50
+
+type Tag struct {
+	id     string
+	userID int64
+	name   string
+}
+
+func NewTag(userID int64, name string) (*Tag, error) {
+	if userID <= 0 {
+		return nil, errors.New("tag must belong to a user")
+	}
+
+	t := &Tag{id: uuid.New().String(), userID: userID}
+	if err := t.SetName(name); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// NormalizeName trims a tag name and checks that it is a single non-empty
+// token of at most 50 characters. Tag names are compared case-insensitively.
+func NormalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return "", errors.New("tag name cannot be empty")
+	}
+
+	if len(name) > maxNameLength {
+		return "", errors.New("tag name must be at most 50 characters long")
+	}
+
+	if strings.ContainsAny(name, ", \t\n") {
+		return "", errors.New("tag name cannot contain commas or whitespace")
+	}
+
+	return name, nil
+}
+
+func (t *Tag) SetName(name string) error {
+	normalized, err := NormalizeName(name)
+	if err != nil {
+		return err
+	}
+	t.name = normalized
+	return nil
+}
+
+func (t *Tag) ID() string {
+	return t.id
+}
+
+func (t *Tag) UserID() int64 {
+	return t.userID
+}
+
+func (t *Tag) Name() string {
+	return t.name
+}
+
+func (t *Tag) SetID(id string) {
+	t.id = id
+}
+
+func (t *Tag) ToDTO() *dto.TagDTO {
+	return &dto.TagDTO{
+		ID:   t.id,
+		Name: t.name,
+	}
+}
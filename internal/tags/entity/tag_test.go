@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTag(t *testing.T) {
+	tag, err := NewTag(1, "  vacation-2026 ")
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, tag.ID())
+	assert.Equal(t, int64(1), tag.UserID())
+	assert.Equal(t, "vacation-2026", tag.Name())
+}
+
+func TestNormalizeName_Invalid(t *testing.T) {
+	for name, input := range map[string]string{
+		"Empty":      "  ",
+		"Too long":   strings.Repeat("x", 51),
+		"Comma":      "a,b",
+		"Whitespace": "two words",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NormalizeName(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewTag_RequiresOwner(t *testing.T) {
+	tag, err := NewTag(0, "reimbursable")
+	assert.Error(t, err)
+	assert.Nil(t, tag)
+}
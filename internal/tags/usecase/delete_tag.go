@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+)
+
+type DeleteTagUseCase struct {
+	store data.Store
+}
+
+func NewDeleteTagUseCase(store data.Store) *DeleteTagUseCase {
+	return &DeleteTagUseCase{store: store}
+}
+
+// Execute deletes the tag and removes it from every expense.
+func (uc *DeleteTagUseCase) Execute(userID int64, id string) error {
+	if err := uc.store.Tags.Delete(userID, id); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}
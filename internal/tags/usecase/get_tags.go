@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/tags/dto"
+)
+
+type GetTagsUseCase struct {
+	store data.Store
+}
+
+func NewGetTagsUseCase(store data.Store) *GetTagsUseCase {
+	return &GetTagsUseCase{store: store}
+}
+
+func (uc *GetTagsUseCase) Execute(userID int64) (result []dto.TagDTO, err error) {
+	tags, err := uc.store.Tags.FindAll(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	result = make([]dto.TagDTO, 0, len(tags))
+	for _, t := range tags {
+		tagDTO := t.Tag.ToDTO()
+		tagDTO.UsageCount = t.UsageCount
+		result = append(result, *tagDTO)
+	}
+
+	return result, nil
+}
+
+// findTagDTO returns the tag together with its usage count.
+func findTagDTO(store data.Store, userID int64, id string) (*dto.TagDTO, error) {
+	tags, err := NewGetTagsUseCase(store).Execute(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tags {
+		if t.ID == id {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", data.ErrTagNotFound, id)
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/tags/dto"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidMerge = appErrors.Validation("a tag cannot be merged into itself")
+
+type MergeTagUseCase struct {
+	store data.Store
+}
+
+func NewMergeTagUseCase(store data.Store) *MergeTagUseCase {
+	return &MergeTagUseCase{store: store}
+}
+
+// Execute retags every expense carrying the source tag with the target tag
+// and deletes the source tag. It returns the target tag.
+func (uc *MergeTagUseCase) Execute(userID int64, sourceID string, input dto.MergeTagDTO) (result *dto.TagDTO, err error) {
+	if sourceID == input.Into {
+		return nil, ErrInvalidMerge
+	}
+
+	if err := uc.store.Tags.Merge(userID, sourceID, input.Into); err != nil {
+		return nil, fmt.Errorf("failed to merge tags: %w", err)
+	}
+
+	return findTagDTO(uc.store, userID, input.Into)
+}
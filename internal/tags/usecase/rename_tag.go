@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/tags/dto"
+	appErrors "github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+var ErrInvalidTag = appErrors.Validation("invalid tag")
+
+type RenameTagUseCase struct {
+	store data.Store
+}
+
+func NewRenameTagUseCase(store data.Store) *RenameTagUseCase {
+	return &RenameTagUseCase{store: store}
+}
+
+// Execute renames the tag on every expense carrying it. Renaming onto the
+// name of another tag fails with data.ErrTagNameTaken; use a merge instead.
+func (uc *RenameTagUseCase) Execute(userID int64, id string, input dto.RenameTagDTO) (result *dto.TagDTO, err error) {
+	tag, err := uc.store.Tags.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tag by ID: %w", err)
+	}
+
+	if err := tag.SetName(input.Name); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTag, err)
+	}
+
+	if err := uc.store.Tags.Update(*tag); err != nil {
+		return nil, fmt.Errorf("failed to save tag: %w", err)
+	}
+
+	return findTagDTO(uc.store, userID, id)
+}
@@ -1,19 +1,78 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
+	"github.com/MarioGN/finance-manager-api/config"
 	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/auth/token"
 	"github.com/MarioGN/finance-manager-api/server"
 )
 
 func main() {
-	store, err := data.NewStore()
+	cfg, args, err := config.Load(os.Args[1:])
 	if err != nil {
-		log.Fatal("Failed to initialize store:", err)
+		log.Fatal("Failed to load configuration: ", err)
 	}
 
-	srv := server.New(store)
+	if len(args) > 0 && args[0] == "migrate" {
+		if err := runMigrate(cfg, args[1:], os.Stdout); err != nil {
+			log.Fatal("Migration failed: ", err)
+		}
+		return
+	}
+
+	if err := cfg.Validate(); err != nil {
+		log.Fatal("Invalid configuration:\n", err)
+	}
+
+	if err := run(cfg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run serves HTTP and runs the background workers until SIGINT or SIGTERM,
+// then drains in-flight requests and closes the store.
+func run(cfg *config.Config) (err error) {
+	store, err := data.NewStore(cfg.DatabaseDSN)
+	if err != nil {
+		return fmt.Errorf("failed to initialize store: %w", err)
+	}
+	defer func() {
+		if closeErr := store.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("failed to close store: %w", closeErr)
+		}
+	}()
+
+	tokens, err := token.NewManager(cfg.TokenSecret, cfg.TokenTTL)
+	if err != nil {
+		return fmt.Errorf("failed to initialize token manager: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// The background workers are stopped before the store is closed,
+	// whichever way the server stops.
+	workersCtx, stopWorkers := context.WithCancel(ctx)
+	var workers sync.WaitGroup
+	workers.Go(func() { runScheduler(workersCtx, store, cfg.RecurringInterval) })
+	workers.Go(func() {
+		runDispatcher(workersCtx, store, cfg.WebhookInterval, cfg.WebhookTimeout, cfg.WebhookAllowPrivate)
+	})
+	workers.Go(func() { runPurger(workersCtx, store, cfg.PurgeInterval, cfg.TrashRetention) })
+	defer func() {
+		stopWorkers()
+		workers.Wait()
+	}()
+
+	srv := server.New(cfg, store, tokens)
 
-	srv.Start()
+	return srv.Start(ctx)
 }
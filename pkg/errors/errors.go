@@ -1,13 +1,173 @@
+// Package errors defines the kinds of failure the API reports. Use cases
+// return or wrap errors of a kind, and the server picks the HTTP status and
+// the response body from the kind in one place.
 package errors
 
-type ApplicationError struct {
-	ErrorMessage string `json:"error_message"`
+import (
+	"errors"
+	"strings"
+)
+
+type Kind int
+
+const (
+	// KindInternal is any error without a kind: a failure the client cannot
+	// do anything about.
+	KindInternal Kind = iota
+	KindValidation
+	KindNotFound
+	KindConflict
+	KindForbidden
+	KindUnauthorized
+	// KindUnprocessable is a valid request that cannot be carried out with
+	// the data at hand, such as a report without the exchange rates it needs.
+	KindUnprocessable
+	// KindPreconditionFailed is a request made on a version of a resource
+	// that is no longer the current one.
+	KindPreconditionFailed
+	// KindPreconditionRequired is a change that must say which version of a
+	// resource it was made on, and did not.
+	KindPreconditionRequired
+)
+
+// Error is an error of a kind. It is meant to be declared once as a sentinel
+// and wrapped with fmt.Errorf, so that errors.Is keeps working.
+type Error struct {
+	kind    Kind
+	message string
+}
+
+func New(kind Kind, message string) *Error {
+	return &Error{kind: kind, message: message}
+}
+
+func Validation(message string) *Error {
+	return New(KindValidation, message)
+}
+
+func NotFound(message string) *Error {
+	return New(KindNotFound, message)
+}
+
+func Conflict(message string) *Error {
+	return New(KindConflict, message)
+}
+
+func Forbidden(message string) *Error {
+	return New(KindForbidden, message)
+}
+
+func Unauthorized(message string) *Error {
+	return New(KindUnauthorized, message)
+}
+
+func Unprocessable(message string) *Error {
+	return New(KindUnprocessable, message)
+}
+
+func PreconditionFailed(message string) *Error {
+	return New(KindPreconditionFailed, message)
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+var (
+	ErrInvalidRequest = Validation("invalid request payload")
+	ErrUnauthorized   = Unauthorized("unauthorized")
+	// ErrPreconditionRequired is a change to a resource without the version
+	// it was made on.
+	ErrPreconditionRequired = New(KindPreconditionRequired, "If-Match header is required")
+)
+
+// kinded is implemented by every error that knows its kind, including error
+// types declared outside this package.
+type kinded interface {
+	error
+	Kind() Kind
+}
+
+// KindOf returns the kind of the outermost error in err's chain that has
+// one, or KindInternal.
+func KindOf(err error) Kind {
+	var k kinded
+	if errors.As(err, &k) {
+		return k.Kind()
+	}
+	return KindInternal
+}
+
+// Detail returns the message of err from its outermost error of a kind on,
+// leaving out the "failed to ..." context added on the way up, which is of
+// no use to a client. Errors without a kind have no detail.
+func Detail(err error) string {
+	var k kinded
+	if !errors.As(err, &k) {
+		return ""
+	}
+
+	message := err.Error()
+	if i := strings.Index(message, k.Error()); i >= 0 {
+		return message[i:]
+	}
+	return k.Error()
+}
+
+// FieldError is a validation failure of one value of a request, found at
+// Pointer, a JSON pointer such as "/amount".
+type FieldError struct {
+	Pointer string `json:"pointer"`
+	Detail  string `json:"detail"`
 }
 
-func NewApplicationError(message string) ApplicationError {
-	return ApplicationError{ErrorMessage: message}
+type fieldError struct {
+	pointer string
+	err     error
 }
 
-var InternnalServerError = NewApplicationError("internal server error")
-var NotFoundError = NewApplicationError("not found")
-var InvalidRequestError = NewApplicationError("invalid request payload")
+// Field marks err as a validation failure of the request value at pointer.
+// The message of err is kept as it is.
+func Field(pointer string, err error) error {
+	return &fieldError{pointer: pointer, err: err}
+}
+
+func (e *fieldError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+func (e *fieldError) Kind() Kind {
+	return KindValidation
+}
+
+// Fields returns every field failure in err's tree, following both wrapped
+// errors and errors joined with errors.Join.
+func Fields(err error) []FieldError {
+	var fields []FieldError
+
+	var walk func(err error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case nil:
+		case *fieldError:
+			fields = append(fields, FieldError{Pointer: e.pointer, Detail: e.err.Error()})
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				walk(inner)
+			}
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		}
+	}
+	walk(err)
+
+	return fields
+}
@@ -0,0 +1,54 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errThingNotFound = NotFound("thing not found")
+
+type quotaError struct{}
+
+func (quotaError) Error() string { return "quota exceeded" }
+func (quotaError) Kind() Kind    { return KindForbidden }
+
+func TestKindOf(t *testing.T) {
+	assert.Equal(t, KindInternal, KindOf(nil))
+	assert.Equal(t, KindInternal, KindOf(errors.New("disk full")))
+	assert.Equal(t, KindNotFound, KindOf(fmt.Errorf("failed to find thing: %w", errThingNotFound)))
+	assert.Equal(t, KindForbidden, KindOf(fmt.Errorf("failed to save: %w", quotaError{})))
+	assert.Equal(t, KindValidation, KindOf(Field("/amount", errors.New("amount must be positive"))))
+
+	invalid := Validation("invalid thing")
+	assert.Equal(t, KindValidation, KindOf(fmt.Errorf("%w: %w", invalid, errThingNotFound)),
+		"The outermost kind should win")
+}
+
+func TestDetail(t *testing.T) {
+	assert.Empty(t, Detail(fmt.Errorf("failed to query: %w", errors.New("database is locked"))))
+	assert.Equal(t, "thing not found", Detail(fmt.Errorf("failed to update thing: %w", errThingNotFound)))
+
+	invalid := Validation("invalid thing")
+	err := fmt.Errorf("failed to create: %w", fmt.Errorf("%w: %w", invalid, errors.New("name is required")))
+	assert.Equal(t, "invalid thing: name is required", Detail(err))
+}
+
+func TestFields(t *testing.T) {
+	invalid := Validation("invalid thing")
+
+	err := fmt.Errorf("%w: %w", invalid, errors.Join(
+		Field("/amount", errors.New("amount must be positive")),
+		fmt.Errorf("wrapped: %w", Field("/date", errors.New("date is required"))),
+	))
+
+	assert.Equal(t, []FieldError{
+		{Pointer: "/amount", Detail: "amount must be positive"},
+		{Pointer: "/date", Detail: "date is required"},
+	}, Fields(err))
+	assert.Equal(t, "invalid thing: amount must be positive\nwrapped: date is required", err.Error())
+
+	assert.Nil(t, Fields(invalid))
+}
@@ -0,0 +1,104 @@
+package jsonpatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{"replaces a member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"adds a member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"null removes a member", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"merges objects", `{"a":{"b":"c","d":"e"}}`, `{"a":{"d":null,"f":"g"}}`, `{"a":{"b":"c","f":"g"}}`},
+		{"replaces arrays whole", `{"a":[1,2]}`, `{"a":[3]}`, `{"a":[3]}`},
+		{"keeps numbers as written", `{"a":1.50}`, `{}`, `{"a":1.50}`},
+		{"a non-object patch replaces the document", `{"a":"b"}`, `["c"]`, `["c"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Merge([]byte(tt.doc), []byte(tt.patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+
+	_, err := Merge([]byte(`{}`), []byte(`{"a":`))
+	assert.ErrorIs(t, err, ErrInvalidPatch)
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{"add", `{"a":1}`, `[{"op":"add","path":"/b","value":2}]`, `{"a":1,"b":2}`},
+		{"add inserts into an array", `{"a":[1,3]}`, `[{"op":"add","path":"/a/1","value":2}]`, `{"a":[1,2,3]}`},
+		{"add appends with -", `{"a":[1]}`, `[{"op":"add","path":"/a/-","value":2}]`, `{"a":[1,2]}`},
+		{"add null", `{}`, `[{"op":"add","path":"/a","value":null}]`, `{"a":null}`},
+		{"remove", `{"a":1,"b":2}`, `[{"op":"remove","path":"/a"}]`, `{"b":2}`},
+		{"remove from an array", `{"a":[1,2,3]}`, `[{"op":"remove","path":"/a/0"}]`, `{"a":[2,3]}`},
+		{"replace", `{"a":{"b":1}}`, `[{"op":"replace","path":"/a/b","value":"x"}]`, `{"a":{"b":"x"}}`},
+		{"replace the document", `{"a":1}`, `[{"op":"replace","path":"","value":[1]}]`, `[1]`},
+		{"move", `{"a":{"b":1},"c":{}}`, `[{"op":"move","from":"/a/b","path":"/c/d"}]`, `{"a":{},"c":{"d":1}}`},
+		{"move to itself", `{"a":1}`, `[{"op":"move","from":"/a","path":"/a"}]`, `{"a":1}`},
+		{"copy", `{"a":{"b":1}}`, `[{"op":"copy","from":"/a","path":"/c"},{"op":"add","path":"/c/b","value":2}]`, `{"a":{"b":1},"c":{"b":2}}`},
+		{"test", `{"a":[1,{"b":"c"}]}`, `[{"op":"test","path":"/a","value":[1.0,{"b":"c"}]}]`, `{"a":[1,{"b":"c"}]}`},
+		{"escaped tokens", `{"a/b":1,"m~n":2}`, `[{"op":"replace","path":"/a~1b","value":3},{"op":"remove","path":"/m~0n"}]`, `{"a/b":3}`},
+		{"in order", `{}`, `[{"op":"add","path":"/a","value":[]},{"op":"add","path":"/a/-","value":1}]`, `{"a":[1]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply([]byte(tt.doc), []byte(tt.patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestApply_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+		want  error
+		index int
+	}{
+		{"unknown op", `[{"op":"swap","path":"/a"}]`, ErrInvalidPatch, 0},
+		{"missing path", `[{"op":"remove"}]`, ErrInvalidPatch, 0},
+		{"missing value", `[{"op":"add","path":"/b"}]`, ErrInvalidPatch, 0},
+		{"not a pointer", `[{"op":"remove","path":"a"}]`, ErrInvalidPatch, 0},
+		{"bad index", `[{"op":"add","path":"/c/01","value":1}]`, ErrInvalidPatch, 0},
+		{"move into itself", `[{"op":"move","from":"/c","path":"/c/0"}]`, ErrInvalidPatch, 0},
+		{"remove a missing member", `[{"op":"remove","path":"/b"}]`, ErrPathNotFound, 0},
+		{"replace a missing member", `[{"op":"add","path":"/b","value":1},{"op":"replace","path":"/d","value":1}]`, ErrPathNotFound, 1},
+		{"add past the end", `[{"op":"add","path":"/c/3","value":1}]`, ErrPathNotFound, 0},
+		{"add under a missing parent", `[{"op":"add","path":"/x/y","value":1}]`, ErrPathNotFound, 0},
+		{"test a different value", `[{"op":"test","path":"/a","value":"2"}]`, ErrTestFailed, 0},
+	}
+
+	doc := []byte(`{"a":1,"c":[1,2]}`)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Apply(doc, []byte(tt.patch))
+			require.ErrorIs(t, err, tt.want)
+
+			var opErr *OperationError
+			require.ErrorAs(t, err, &opErr)
+			assert.Equal(t, tt.index, opErr.Index)
+		})
+	}
+
+	_, err := Apply(doc, []byte(`{"op":"remove","path":"/a"}`))
+	assert.ErrorIs(t, err, ErrInvalidPatch, "A patch should be an array")
+}
@@ -0,0 +1,59 @@
+// Package locale holds the number and date conventions used to read and
+// write spreadsheets for people rather than programs.
+package locale
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+)
+
+// Format says how amounts and dates are written.
+type Format struct {
+	// DecimalSeparator is "." or ",".
+	DecimalSeparator string
+	// DateFormat is written with YYYY, YY, MM and DD, e.g. DD.MM.YYYY.
+	DateFormat string
+}
+
+// ISO writes amounts with a decimal point and dates as YYYY-MM-DD.
+var ISO = Format{DecimalSeparator: ".", DateFormat: "YYYY-MM-DD"}
+
+var formats = map[string]Format{
+	"en-us": {DecimalSeparator: ".", DateFormat: "MM/DD/YYYY"},
+	"en-gb": {DecimalSeparator: ".", DateFormat: "DD/MM/YYYY"},
+	"de-de": {DecimalSeparator: ",", DateFormat: "DD.MM.YYYY"},
+	"de-at": {DecimalSeparator: ",", DateFormat: "DD.MM.YYYY"},
+	"de-ch": {DecimalSeparator: ".", DateFormat: "DD.MM.YYYY"},
+	"fr-fr": {DecimalSeparator: ",", DateFormat: "DD/MM/YYYY"},
+	"es-es": {DecimalSeparator: ",", DateFormat: "DD/MM/YYYY"},
+	"it-it": {DecimalSeparator: ",", DateFormat: "DD/MM/YYYY"},
+	"nl-nl": {DecimalSeparator: ",", DateFormat: "DD-MM-YYYY"},
+	"pt-br": {DecimalSeparator: ",", DateFormat: "DD/MM/YYYY"},
+}
+
+// Lookup returns the format of a language tag such as de-DE, ignoring case
+// and accepting an underscore for the hyphen.
+func Lookup(tag string) (Format, bool) {
+	f, ok := formats[strings.ReplaceAll(strings.ToLower(tag), "_", "-")]
+	return f, ok
+}
+
+// DateLayout turns a format such as DD/MM/YYYY into a Go time layout.
+func DateLayout(format string) (string, error) {
+	layout := strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02").Replace(strings.ToUpper(format))
+	if strings.ContainsAny(layout, "YMD") || !strings.Contains(layout, "01") || !strings.Contains(layout, "02") {
+		return "", fmt.Errorf("unsupported date_format %q; use YYYY, YY, MM and DD, e.g. DD/MM/YYYY", format)
+	}
+	return layout, nil
+}
+
+// Amount writes an amount with the decimal separator of f and no thousands
+// separator, so that spreadsheets read it back as a number.
+func (f Format) Amount(amount money.Amount) string {
+	if f.DecimalSeparator == "," {
+		return strings.Replace(amount.String(), ".", ",", 1)
+	}
+	return amount.String()
+}
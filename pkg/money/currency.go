@@ -0,0 +1,31 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultCurrency is the currency assumed for amounts recorded before
+// currencies were tracked and for users who have not chosen one.
+const DefaultCurrency = "EUR"
+
+var ErrInvalidCurrency = errors.New("currency must be a three-letter ISO 4217 code")
+
+// ParseCurrency normalizes an ISO 4217 alphabetic code such as "usd" to
+// "USD". It only checks the shape of the code, not that it is assigned.
+func ParseCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	if len(code) != 3 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCurrency, code)
+	}
+
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", fmt.Errorf("%w: %q", ErrInvalidCurrency, code)
+		}
+	}
+
+	return code, nil
+}
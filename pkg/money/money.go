@@ -0,0 +1,123 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// Amount is a monetary value in minor units (cents). It is never converted
+// through floating point: JSON input is either a decimal string such as
+// "12.34" or an integer number of minor units such as 1234, and output is
+// always a decimal string.
+type Amount int64
+
+// Parse converts a decimal string with up to two fractional digits into
+// minor units.
+func Parse(value string) (Amount, error) {
+	s := strings.TrimSpace(value)
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty value", ErrInvalidAmount)
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, fraction, hasFraction := strings.Cut(s, ".")
+	if whole == "" && (!hasFraction || fraction == "") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	if hasFraction && (fraction == "" || len(fraction) > 2) {
+		return 0, fmt.Errorf("%w: %q must have one or two decimal places", ErrInvalidAmount, value)
+	}
+	if !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	units := int64(0)
+	if whole != "" {
+		var err error
+		units, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil || units > (math.MaxInt64-99)/100 {
+			return 0, fmt.Errorf("%w: %q is out of range", ErrInvalidAmount, value)
+		}
+	}
+
+	cents, _ := strconv.ParseInt(fraction, 10, 64)
+	total := units*100 + cents
+
+	if negative {
+		total = -total
+	}
+
+	return Amount(total), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (a Amount) String() string {
+	sign := ""
+	v := uint64(a)
+	if a < 0 {
+		// Negated as an unsigned value, so that the smallest amount, whose
+		// magnitude does not fit an int64, is still formatted right.
+		sign = "-"
+		v = -v
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+
+		parsed, err := Parse(s)
+		if err != nil {
+			return err
+		}
+
+		*a = parsed
+		return nil
+	}
+
+	minor, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: numbers must be integer minor units, use a decimal string for fractional amounts", ErrInvalidAmount)
+	}
+
+	*a = Amount(minor)
+	return nil
+}
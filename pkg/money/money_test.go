@@ -0,0 +1,76 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Amount
+	}{
+		{input: "12.34", expected: 1234},
+		{input: "0.29", expected: 29},
+		{input: "0.1", expected: 10},
+		{input: "7", expected: 700},
+		{input: ".5", expected: 50},
+		{input: "-3.05", expected: -305},
+		{input: " 1500.00 ", expected: 150000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			amount, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, amount)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, input := range []string{"", "-", ".", "1.", "1.234", "1,50", "abc", "1e3", "99999999999999999999"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := Parse(input)
+			assert.ErrorIs(t, err, ErrInvalidAmount)
+		})
+	}
+}
+
+func TestAmount_String(t *testing.T) {
+	assert.Equal(t, "12.34", Amount(1234).String())
+	assert.Equal(t, "0.05", Amount(5).String())
+	assert.Equal(t, "-1.50", Amount(-150).String())
+	assert.Equal(t, "0.00", Amount(0).String())
+	assert.Equal(t, "-92233720368547758.08", Amount(math.MinInt64).String())
+	assert.Equal(t, "92233720368547758.07", Amount(math.MaxInt64).String())
+}
+
+func TestAmount_JSON(t *testing.T) {
+	t.Run("Decimal string", func(t *testing.T) {
+		var a Amount
+		require.NoError(t, json.Unmarshal([]byte(`"0.29"`), &a))
+		assert.Equal(t, Amount(29), a)
+	})
+
+	t.Run("Integer minor units", func(t *testing.T) {
+		var a Amount
+		require.NoError(t, json.Unmarshal([]byte(`1234`), &a))
+		assert.Equal(t, Amount(1234), a)
+	})
+
+	t.Run("Fractional numbers are rejected", func(t *testing.T) {
+		var a Amount
+		assert.Error(t, json.Unmarshal([]byte(`12.34`), &a))
+	})
+
+	t.Run("Marshals as decimal string", func(t *testing.T) {
+		out, err := json.Marshal(Amount(1234))
+		require.NoError(t, err)
+		assert.JSONEq(t, `"12.34"`, string(out))
+	})
+}
@@ -0,0 +1,84 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// Rate is an exchange rate: how many units of the quote currency one unit of
+// the base currency buys. It is kept as an exact fraction so that decimal
+// rates such as "1.0842" convert amounts without floating point error.
+type Rate struct {
+	r *big.Rat
+}
+
+// ParseRate accepts a positive decimal string such as "1.0842" or "162.85".
+func ParseRate(value string) (Rate, error) {
+	s := strings.TrimSpace(value)
+	if s == "" || strings.ContainsAny(s, "/eE") {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, value)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || r.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("%w: %q must be a positive decimal", ErrInvalidRate, value)
+	}
+
+	return Rate{r: r}, nil
+}
+
+// IsZero reports whether the rate was never set.
+func (r Rate) IsZero() bool {
+	return r.r == nil
+}
+
+// Invert returns the rate for the opposite direction.
+func (r Rate) Invert() Rate {
+	return Rate{r: new(big.Rat).Inv(r.r)}
+}
+
+// Mul chains two rates, e.g. USD->EUR and EUR->GBP into USD->GBP.
+func (r Rate) Mul(other Rate) Rate {
+	return Rate{r: new(big.Rat).Mul(r.r, other.r)}
+}
+
+// Convert converts an amount from the base into the quote currency,
+// rounding half away from zero to the nearest minor unit.
+func (r Rate) Convert(a Amount) Amount {
+	product := new(big.Rat).Mul(big.NewRat(int64(a), 1), r.r)
+
+	num := new(big.Int).Set(product.Num())
+	den := product.Denom()
+
+	negative := num.Sign() < 0
+	num.Abs(num)
+
+	// (2*num + den) / (2*den) rounds half up on the absolute value.
+	num.Mul(num, big.NewInt(2)).Add(num, den)
+	quotient := num.Quo(num, new(big.Int).Mul(den, big.NewInt(2)))
+
+	if negative {
+		quotient.Neg(quotient)
+	}
+
+	return Amount(quotient.Int64())
+}
+
+// String formats the rate as a decimal with up to ten fractional digits and
+// no trailing zeros.
+func (r Rate) String() string {
+	if r.r == nil {
+		return "0"
+	}
+
+	s := r.r.FloatString(10)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
@@ -0,0 +1,67 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+	code, err := ParseCurrency(" usd ")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", code)
+
+	for _, invalid := range []string{"", "US", "EURO", "U$D", "12A"} {
+		_, err := ParseCurrency(invalid)
+		assert.ErrorIs(t, err, ErrInvalidCurrency, "%q should be rejected", invalid)
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	rate, err := ParseRate("1.0842")
+	require.NoError(t, err)
+	assert.Equal(t, "1.0842", rate.String())
+
+	rate, err = ParseRate("162.850")
+	require.NoError(t, err)
+	assert.Equal(t, "162.85", rate.String())
+
+	for _, invalid := range []string{"", "0", "-1.2", "abc", "1/3", "1e3"} {
+		_, err := ParseRate(invalid)
+		assert.ErrorIs(t, err, ErrInvalidRate, "%q should be rejected", invalid)
+	}
+}
+
+func TestRate_Convert(t *testing.T) {
+	usdPerEUR, err := ParseRate("1.0842")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		rate     Rate
+		amount   Amount
+		expected Amount
+	}{
+		{name: "EUR to USD", rate: usdPerEUR, amount: 10000, expected: 10842},
+		{name: "USD to EUR", rate: usdPerEUR.Invert(), amount: 10842, expected: 10000},
+		{name: "Rounds half away from zero", rate: mustParseRate(t, "0.5"), amount: 3, expected: 2},
+		{name: "Negative amounts round symmetrically", rate: mustParseRate(t, "0.5"), amount: -3, expected: -2},
+		{name: "Chained rates", rate: usdPerEUR.Invert().Mul(mustParseRate(t, "0.85")), amount: 10842, expected: 8500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rate.Convert(tt.amount))
+		})
+	}
+}
+
+func mustParseRate(t *testing.T, value string) Rate {
+	t.Helper()
+
+	rate, err := ParseRate(value)
+	require.NoError(t, err)
+
+	return rate
+}
@@ -0,0 +1,82 @@
+// Package validation collects every violation of a request, each at the JSON
+// pointer (RFC 6901) of the value at fault, so that a client learns about all
+// of them at once rather than one per attempt.
+package validation
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+)
+
+// Violations is the set of violations found so far. The zero value is empty
+// and ready to use.
+type Violations struct {
+	errs     []error
+	pointers map[string]bool
+}
+
+// Add records err as a violation of the value at pointer. Only the first
+// violation of a value is kept: a date that cannot be parsed need not also be
+// reported as missing.
+func (v *Violations) Add(pointer string, err error) {
+	if v.pointers[pointer] {
+		return
+	}
+	if v.pointers == nil {
+		v.pointers = make(map[string]bool)
+	}
+	v.pointers[pointer] = true
+	v.errs = append(v.errs, errors.Field(pointer, err))
+}
+
+// Check records err as a violation of the value at pointer unless it is nil.
+func (v *Violations) Check(pointer string, err error) {
+	if err != nil {
+		v.Add(pointer, err)
+	}
+}
+
+// Err returns the violations as one validation error, or nil when there are
+// none. Each violation stays reachable through errors.Is and errors.Fields.
+func (v *Violations) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return violationsError(v.errs)
+}
+
+type violationsError []error
+
+func (e violationsError) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e violationsError) Unwrap() []error {
+	return e
+}
+
+func (e violationsError) Kind() errors.Kind {
+	return errors.KindValidation
+}
+
+// Pointer builds a JSON pointer from reference tokens, escaping "~" and "/"
+// in them. Pointer("rows", 3, "amount") is "/rows/3/amount".
+func Pointer(tokens ...any) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteByte('/')
+		switch t := token.(type) {
+		case int:
+			b.WriteString(strconv.Itoa(t))
+		case string:
+			b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(t))
+		}
+	}
+	return b.String()
+}
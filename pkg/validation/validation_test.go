@@ -0,0 +1,39 @@
+package validation
+
+import (
+	stdErrors "errors"
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViolations(t *testing.T) {
+	var v Violations
+	require.NoError(t, v.Err(), "No violations should be no error")
+
+	errTooLong := stdErrors.New("description is too long")
+
+	v.Check("/amount", nil)
+	v.Add("/date", stdErrors.New("date must be in YYYY-MM-DD format"))
+	v.Add("/date", stdErrors.New("date must be a valid date"))
+	v.Check("/description", errTooLong)
+
+	err := v.Err()
+	require.Error(t, err)
+	assert.Equal(t, "date must be in YYYY-MM-DD format; description is too long", err.Error())
+	assert.ErrorIs(t, err, errTooLong)
+	assert.Equal(t, errors.KindValidation, errors.KindOf(err))
+	assert.Equal(t, []errors.FieldError{
+		{Pointer: "/date", Detail: "date must be in YYYY-MM-DD format"},
+		{Pointer: "/description", Detail: "description is too long"},
+	}, errors.Fields(err))
+}
+
+func TestPointer(t *testing.T) {
+	assert.Equal(t, "/amount", Pointer("amount"))
+	assert.Equal(t, "/rows/3/amount", Pointer("rows", 3, "amount"))
+	assert.Equal(t, "/a~1b/m~0n", Pointer("a/b", "m~n"))
+	assert.Equal(t, "", Pointer())
+}
@@ -0,0 +1,225 @@
+// Package xlsx writes a single-sheet Office Open XML workbook row by row,
+// without holding the rows in memory. Strings are written inline rather than
+// in a shared string table for the same reason.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of an XLSX workbook.
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// Styles of the cellXfs table in styles.xml.
+const (
+	styleDefault = 0
+	styleHeader  = 1
+	styleDate    = 2
+	styleNumber  = 3
+)
+
+type cellKind int
+
+const (
+	stringCell cellKind = iota
+	numberCell
+	dateCell
+)
+
+// Cell is one value of a row.
+type Cell struct {
+	kind  cellKind
+	value string
+}
+
+// String is a text cell.
+func String(value string) Cell {
+	return Cell{kind: stringCell, value: value}
+}
+
+// Number is a numeric cell written from a decimal string such as "-12.50".
+// It is shown with two decimals and a thousands separator.
+func Number(decimal string) Cell {
+	return Cell{kind: numberCell, value: decimal}
+}
+
+// Date is a date cell shown with the date format of the writer.
+func Date(date time.Time) Cell {
+	return Cell{kind: dateCell, value: strconv.Itoa(excelDay(date))}
+}
+
+// excelDay returns the serial number Excel uses for a date, counting days
+// from 30 December 1899.
+func excelDay(date time.Time) int {
+	epoch := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(epoch).Hours() / 24)
+}
+
+type Writer struct {
+	zip   *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+// NewWriter starts a workbook with one sheet. dateFormat is an Excel number
+// format such as dd.mm.yyyy.
+func NewWriter(w io.Writer, sheetName, dateFormat string) (*Writer, error) {
+	if sheetName == "" || len(sheetName) > 31 || strings.ContainsAny(sheetName, `:\/?*[]`) {
+		return nil, fmt.Errorf("invalid sheet name %q", sheetName)
+	}
+
+	archive := zip.NewWriter(w)
+
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", rootRelsXML},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXML, escape(sheetName))},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+		{"xl/styles.xml", fmt.Sprintf(stylesXML, escape(dateFormat))},
+	}
+
+	for _, part := range parts {
+		f, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	// The sheet is the last part, so that rows can be written to it until
+	// the workbook is closed.
+	f, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(xml.Header + `<worksheet xmlns="` + mainNamespace + `"><sheetData>`); err != nil {
+		return nil, err
+	}
+
+	return &Writer{zip: archive, sheet: sheet}, nil
+}
+
+// WriteHeader writes a row of column names in bold.
+func (w *Writer) WriteHeader(names ...string) error {
+	cells := make([]Cell, 0, len(names))
+	for _, name := range names {
+		cells = append(cells, String(name))
+	}
+	return w.writeRow(styleHeader, cells)
+}
+
+func (w *Writer) WriteRow(cells ...Cell) error {
+	return w.writeRow(styleDefault, cells)
+}
+
+func (w *Writer) writeRow(stringStyle int, cells []Cell) error {
+	row := w.rows + 1
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, row)
+
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(row)
+
+		switch cell.kind {
+		case numberCell:
+			if _, err := strconv.ParseFloat(cell.value, 64); err != nil {
+				return fmt.Errorf("cell %s: %q is not a number", ref, cell.value)
+			}
+			fmt.Fprintf(&b, `<c r="%s" s="%d"><v>%s</v></c>`, ref, styleNumber, cell.value)
+		case dateCell:
+			fmt.Fprintf(&b, `<c r="%s" s="%d"><v>%s</v></c>`, ref, styleDate, cell.value)
+		default:
+			if cell.value == "" {
+				continue
+			}
+			fmt.Fprintf(&b, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, stringStyle, escape(cell.value))
+		}
+	}
+
+	b.WriteString(`</row>`)
+
+	if _, err := w.sheet.WriteString(b.String()); err != nil {
+		return err
+	}
+
+	w.rows = row
+	return nil
+}
+
+// Close ends the sheet and the workbook. It does not close the underlying
+// writer.
+func (w *Writer) Close() error {
+	_, err := w.sheet.WriteString(`</sheetData></worksheet>`)
+	return errors.Join(err, w.sheet.Flush(), w.zip.Close())
+}
+
+// columnName returns the letters of the zero-based column i: A to Z, then
+// AA and so on.
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+const (
+	mainNamespace = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+
+	contentTypesXML = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`
+
+	rootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	workbookXML = xml.Header + `<workbook xmlns="` + mainNamespace + `" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+
+	workbookRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`
+
+	// Number format 4 is the built-in #,##0.00; 164 is the first custom id.
+	stylesXML = xml.Header + `<styleSheet xmlns="` + mainNamespace + `">` +
+		`<numFmts count="1"><numFmt numFmtId="164" formatCode="%s"/></numFmts>` +
+		`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="4">` +
+		`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+		`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`</cellXfs>` +
+		`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+		`</styleSheet>`
+)
@@ -0,0 +1,63 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf, "Expenses", "dd.mm.yyyy")
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteHeader("Date", "Description", "Amount"))
+	require.NoError(t, w.WriteRow(Date(time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)), String("Fish & <Chips>"), Number("-12.50")))
+	require.NoError(t, w.WriteRow(Date(time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)), String(""), Number("0.00")))
+	assert.Error(t, w.WriteRow(Number("12,50")))
+	require.NoError(t, w.Close())
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	parts := make(map[string]string)
+	for _, f := range archive.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+
+		parts[f.Name] = string(content)
+		assert.NoError(t, xml.Unmarshal(content, new(struct{})), "%s should be well-formed", f.Name)
+	}
+
+	assert.Contains(t, parts, "[Content_Types].xml")
+	assert.Contains(t, parts["xl/workbook.xml"], `name="Expenses"`)
+	assert.Contains(t, parts["xl/styles.xml"], `formatCode="dd.mm.yyyy"`)
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<c r="A2" s="2"><v>46114</v></c>`)
+	assert.Contains(t, sheet, `<t xml:space="preserve">Fish &amp; &lt;Chips&gt;</t>`)
+	assert.Contains(t, sheet, `<c r="C2" s="3"><v>-12.50</v></c>`)
+	assert.Contains(t, sheet, `<c r="A3" s="2"><v>61</v></c>`)
+	assert.NotContains(t, sheet, `r="B3"`, "Empty strings should be left out")
+}
+
+func TestColumnName(t *testing.T) {
+	for i, expected := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, expected, columnName(i))
+	}
+}
+
+func TestNewWriter_InvalidSheetName(t *testing.T) {
+	_, err := NewWriter(io.Discard, "Expenses/2026", "yyyy-mm-dd")
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/usecase"
+)
+
+// runPurger deletes for good the expenses that have been in the trash for
+// longer than retention, right away and then every interval, until ctx is
+// cancelled.
+func runPurger(ctx context.Context, store *data.Store, interval, retention time.Duration) {
+	uc := usecase.NewPurgeTrashUseCase(*store, retention)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := uc.Execute(time.Now())
+		if err != nil {
+			log.Print("Failed to purge the trash: ", err)
+		}
+		if purged > 0 {
+			log.Printf("Purged %d expenses from the trash", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/usecase"
+)
+
+// runScheduler creates the expenses due from recurring rules right away and
+// then every interval, until ctx is cancelled.
+func runScheduler(ctx context.Context, store *data.Store, interval time.Duration) {
+	uc := usecase.NewMaterializeUseCase(*store)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		created, err := uc.Execute(time.Now())
+		if err != nil {
+			log.Print("Failed to create recurring expenses: ", err)
+		}
+		if created > 0 {
+			log.Printf("Created %d recurring expenses", created)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
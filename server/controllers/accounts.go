@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/dto"
+	"github.com/MarioGN/finance-manager-api/internal/accounts/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type accountController struct {
+	store *data.Store
+}
+
+func ConfigureAccountRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &accountController{store: store}
+
+	group.GET("", ctrl.handleGetAccounts)
+	group.POST("", ctrl.handleCreateAccount)
+	group.GET("/:id", ctrl.handleGetAccountByID)
+	group.PUT("/:id", ctrl.handleUpdateAccount)
+	group.DELETE("/:id", ctrl.handleDeleteAccount)
+	group.GET("/:id/ledger", ctrl.handleGetLedger)
+}
+
+func ConfigureTransferRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &accountController{store: store}
+
+	group.GET("", ctrl.handleGetTransfers)
+	group.POST("", ctrl.handleCreateTransfer)
+	group.GET("/:id", ctrl.handleGetTransferByID)
+	group.DELETE("/:id", ctrl.handleDeleteTransfer)
+}
+
+func (ctrl *accountController) handleGetAccounts(c echo.Context) error {
+	uc := usecase.NewGetAccountsUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *accountController) handleCreateAccount(c echo.Context) error {
+	var req dto.AccountDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewCreateAccountUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+func (ctrl *accountController) handleGetAccountByID(c echo.Context) error {
+	uc := usecase.NewGetAccountUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *accountController) handleUpdateAccount(c echo.Context) error {
+	var req dto.AccountDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewUpdateAccountUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *accountController) handleDeleteAccount(c echo.Context) error {
+	uc := usecase.NewDeleteAccountUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (ctrl *accountController) handleGetLedger(c echo.Context) error {
+	var query dto.LedgerQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetLedgerUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *accountController) handleGetTransfers(c echo.Context) error {
+	var query dto.TransferQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetTransfersUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *accountController) handleCreateTransfer(c echo.Context) error {
+	var req dto.TransferDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewCreateTransferUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+func (ctrl *accountController) handleGetTransferByID(c echo.Context) error {
+	uc := usecase.NewGetTransferUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *accountController) handleDeleteTransfer(c echo.Context) error {
+	uc := usecase.NewDeleteTransferUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
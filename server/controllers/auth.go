@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/auth/dto"
+	"github.com/MarioGN/finance-manager-api/internal/auth/token"
+	"github.com/MarioGN/finance-manager-api/internal/auth/usecase"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type authController struct {
+	store  *data.Store
+	tokens *token.Manager
+}
+
+func ConfigureAuthRoutes(group *echo.Group, store *data.Store, tokens *token.Manager) {
+	ctrl := &authController{store: store, tokens: tokens}
+
+	group.POST("/register", ctrl.handleRegister)
+	group.POST("/login", ctrl.handleLogin)
+	group.PUT("/password", ctrl.handleChangePassword, middleware.RequireAuth(tokens))
+	group.GET("/me", ctrl.handleGetProfile, middleware.RequireAuth(tokens))
+	group.PUT("/me", ctrl.handleUpdateProfile, middleware.RequireAuth(tokens))
+}
+
+func (ctrl *authController) handleRegister(c echo.Context) error {
+	var req dto.RegisterUserDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	res, err := usecase.RegisterUser(ctrl.store.Users, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+func (ctrl *authController) handleLogin(c echo.Context) error {
+	var req dto.LoginUserDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	res, err := usecase.LoginUser(ctrl.store.Users, ctrl.tokens, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *authController) handleChangePassword(c echo.Context) error {
+	var req dto.ChangePasswordDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	err := usecase.ChangePassword(ctrl.store.Users, middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (ctrl *authController) handleGetProfile(c echo.Context) error {
+	res, err := usecase.GetProfile(ctrl.store.Users, middleware.UserID(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *authController) handleUpdateProfile(c echo.Context) error {
+	var req dto.UpdateProfileDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	res, err := usecase.UpdateProfile(ctrl.store.Users, middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
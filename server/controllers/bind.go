@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/pkg/money"
+	"github.com/labstack/echo/v4"
+)
+
+// bind reads the request into req. A JSON value of the wrong type is
+// reported at its JSON pointer and an amount that cannot be read with its
+// reason; any other failure is an invalid payload.
+func bind(c echo.Context, req any) error {
+	err := c.Bind(req)
+	if err == nil {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if stdErrors.As(err, &typeErr) && typeErr.Field != "" {
+		pointer := "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		return fmt.Errorf("%w: %w", errors.ErrInvalidRequest,
+			errors.Field(pointer, fmt.Errorf("unexpected JSON %s", typeErr.Value)))
+	}
+
+	if stdErrors.Is(err, money.ErrInvalidAmount) {
+		return fmt.Errorf("%w: %w", errors.ErrInvalidRequest, err)
+	}
+
+	return errors.ErrInvalidRequest
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/dto"
+	"github.com/MarioGN/finance-manager-api/internal/budgets/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type budgetController struct {
+	store *data.Store
+}
+
+func ConfigureBudgetRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &budgetController{store: store}
+
+	group.GET("", ctrl.handleGetBudgets)
+	group.POST("", ctrl.handleCreateBudget)
+	group.GET("/status", ctrl.handleGetBudgetStatus)
+	group.GET("/:id", ctrl.handleGetBudgetByID)
+	group.PUT("/:id", ctrl.handleUpdateBudget)
+	group.DELETE("/:id", ctrl.handleDeleteBudget)
+}
+
+func (ctrl *budgetController) handleGetBudgets(c echo.Context) error {
+	uc := usecase.NewGetBudgetsUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *budgetController) handleCreateBudget(c echo.Context) error {
+	var req dto.BudgetDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewCreateBudgetUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+func (ctrl *budgetController) handleGetBudgetStatus(c echo.Context) error {
+	var query dto.BudgetStatusQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetBudgetStatusUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *budgetController) handleGetBudgetByID(c echo.Context) error {
+	uc := usecase.NewGetBudgetUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *budgetController) handleUpdateBudget(c echo.Context) error {
+	var req dto.BudgetDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewUpdateBudgetUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *budgetController) handleDeleteBudget(c echo.Context) error {
+	uc := usecase.NewDeleteBudgetUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
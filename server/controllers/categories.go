@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/categories/dto"
+	"github.com/MarioGN/finance-manager-api/internal/categories/usecase"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type categoryController struct {
+	store *data.Store
+}
+
+func ConfigureCategoryRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &categoryController{store: store}
+
+	group.GET("", ctrl.handleGetCategories)
+	group.POST("", ctrl.handleCreateCategory)
+	group.GET("/:id", ctrl.handleGetCategoryByID)
+	group.PUT("/:id", ctrl.handleUpdateCategory)
+	group.DELETE("/:id", ctrl.handleDeleteCategory)
+}
+
+func (ctrl *categoryController) handleGetCategories(c echo.Context) error {
+	uc := usecase.NewGetCategoriesUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *categoryController) handleCreateCategory(c echo.Context) error {
+	var req dto.CategoryDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewCreateCategoryUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+func (ctrl *categoryController) handleGetCategoryByID(c echo.Context) error {
+	uc := usecase.NewGetCategoryUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *categoryController) handleUpdateCategory(c echo.Context) error {
+	var req dto.CategoryDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewUpdateCategoryUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *categoryController) handleDeleteCategory(c echo.Context) error {
+	uc := usecase.NewDeleteCategoryUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
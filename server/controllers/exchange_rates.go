@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"mime"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/dto"
+	"github.com/MarioGN/finance-manager-api/internal/exchangerates/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type exchangeRateController struct {
+	store *data.Store
+}
+
+func ConfigureExchangeRateRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &exchangeRateController{store: store}
+
+	group.GET("", ctrl.handleGetExchangeRates)
+	group.PUT("", ctrl.handleSaveExchangeRates)
+	group.POST("/import", ctrl.handleImportExchangeRates)
+}
+
+func (ctrl *exchangeRateController) handleGetExchangeRates(c echo.Context) error {
+	var query dto.ExchangeRateQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetExchangeRatesUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *exchangeRateController) handleSaveExchangeRates(c echo.Context) error {
+	var req []dto.ExchangeRateDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewSaveExchangeRatesUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+// handleImportExchangeRates reads a rate file from the raw request body. The
+// format comes from the format query parameter or, failing that, from the
+// Content-Type: text/csv for CSV and XML types for the ECB reference rates.
+func (ctrl *exchangeRateController) handleImportExchangeRates(c echo.Context) error {
+	format := usecase.ImportFormat(c.QueryParam("format"))
+	if format == "" {
+		mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+		switch mediaType {
+		case "text/csv":
+			format = usecase.ImportCSV
+		case echo.MIMEApplicationXML, echo.MIMETextXML:
+			format = usecase.ImportECB
+		}
+	}
+
+	uc := usecase.NewImportExchangeRatesUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), format, c.Request().Body)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
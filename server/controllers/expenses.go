@@ -1,10 +1,16 @@
 package controller
 
 import (
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+
 	"github.com/MarioGN/finance-manager-api/data"
 	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
 	"github.com/MarioGN/finance-manager-api/internal/expenses/usecase"
 	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
 	"github.com/labstack/echo/v4"
 )
 
@@ -17,17 +23,25 @@ func ConfigureExpenseRoutes(group *echo.Group, store *data.Store) {
 
 	group.GET("", ctrl.handleGetExpenses)
 	group.POST("", ctrl.handleCreateExpense)
+	group.GET("/trash", ctrl.handleGetTrash)
 	group.GET("/:id", ctrl.handleGetExpenseByID)
 	group.PUT("/:id", ctrl.handleUpdateExpense)
+	group.PATCH("/:id", ctrl.handlePatchExpense)
 	group.DELETE("/:id", ctrl.handleDeleteExpense)
+	group.POST("/:id/restore", ctrl.handleRestoreExpense)
 }
 
 func (ctrl *expenseController) handleGetExpenses(c echo.Context) error {
+	var query dto.ExpenseQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
 	uc := usecase.NewGetExpensesUseCase(*ctrl.store)
 
-	res, err := uc.Execute()
+	res, err := uc.Execute(middleware.UserID(c), query)
 	if err != nil {
-		return c.JSON(500, errors.InternnalServerError)
+		return err
 	}
 
 	return c.JSON(200, res)
@@ -35,17 +49,18 @@ func (ctrl *expenseController) handleGetExpenses(c echo.Context) error {
 
 func (ctrl *expenseController) handleCreateExpense(c echo.Context) error {
 	var req dto.ExpenseDTO
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(400, errors.InvalidRequestError)
+	if err := bind(c, &req); err != nil {
+		return err
 	}
 
 	uc := usecase.NewCreateExpenseUseCase(*ctrl.store)
 
-	res, err := uc.Execute(req)
+	res, err := uc.Execute(middleware.UserID(c), req)
 	if err != nil {
-		return c.JSON(500, errors.InternnalServerError)
+		return err
 	}
 
+	c.Response().Header().Set("ETag", etag(res.Version))
 	return c.JSON(201, res)
 }
 
@@ -53,40 +68,155 @@ func (ctrl *expenseController) handleGetExpenseByID(c echo.Context) error {
 	id := c.Param("id")
 	uc := usecase.NewGetExpenseUseCase(*ctrl.store)
 
-	res, err := uc.Execute(id)
-	if err != nil || res == nil {
-		return c.JSON(404, errors.NotFoundError)
+	res, err := uc.Execute(middleware.UserID(c), id)
+	if err != nil {
+		return err
 	}
 
+	c.Response().Header().Set("ETag", etag(res.Version))
 	return c.JSON(200, res)
 }
 
 func (ctrl *expenseController) handleUpdateExpense(c echo.Context) error {
+	version, err := ifMatch(c)
+	if err != nil {
+		return err
+	}
+
 	var req dto.ExpenseDTO
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(400, errors.InvalidRequestError)
+	if err := bind(c, &req); err != nil {
+		return err
 	}
 
 	id := c.Param("id")
 	uc := usecase.NewUpdateExpenseUseCase(*ctrl.store)
 
-	res, err := uc.Execute(id, req)
+	res, err := uc.Execute(middleware.UserID(c), id, version, req)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set("ETag", etag(res.Version))
+	return c.JSON(200, res)
+}
+
+// maxPatchSize is the largest patch document a PATCH request may carry.
+const maxPatchSize = 1 << 20
+
+// patchFormats maps the media types a PATCH request may have to the patch
+// format they carry. Plain JSON is read as a merge patch.
+var patchFormats = map[string]usecase.PatchFormat{
+	"application/merge-patch+json": usecase.MergePatch,
+	"application/json":             usecase.MergePatch,
+	"application/json-patch+json":  usecase.JSONPatch,
+}
+
+func (ctrl *expenseController) handlePatchExpense(c echo.Context) error {
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	format, ok := patchFormats[mediaType]
+	if !ok {
+		c.Response().Header().Set("Accept-Patch", "application/merge-patch+json, application/json-patch+json")
+		return echo.ErrUnsupportedMediaType
+	}
+
+	version, err := ifMatch(c)
+	if err != nil {
+		return err
+	}
+
+	patch, err := io.ReadAll(io.LimitReader(c.Request().Body, maxPatchSize+1))
 	if err != nil {
-		return c.JSON(500, errors.InternnalServerError)
+		return errors.ErrInvalidRequest
+	}
+	if len(patch) > maxPatchSize {
+		return echo.ErrStatusRequestEntityTooLarge
 	}
 
+	id := c.Param("id")
+	uc := usecase.NewPatchExpenseUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), id, version, format, patch)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set("ETag", etag(res.Version))
 	return c.JSON(200, res)
 }
 
 func (ctrl *expenseController) handleDeleteExpense(c echo.Context) error {
+	version, err := ifMatch(c)
+	if err != nil {
+		return err
+	}
+
 	id := c.Param("id")
 
 	uc := usecase.NewDeleteExpenseUseCase(*ctrl.store)
 
-	err := uc.Execute(id)
-	if err != nil {
-		return c.JSON(500, errors.InternnalServerError)
+	if err := uc.Execute(middleware.UserID(c), id, version); err != nil {
+		return err
 	}
 
 	return c.NoContent(204)
 }
+
+func (ctrl *expenseController) handleGetTrash(c echo.Context) error {
+	var query dto.ExpenseQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetTrashUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *expenseController) handleRestoreExpense(c echo.Context) error {
+	id := c.Param("id")
+	uc := usecase.NewRestoreExpenseUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), id)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set("ETag", etag(res.Version))
+	return c.JSON(200, res)
+}
+
+var errUnknownETag = errors.PreconditionFailed("If-Match does not name a version of the expense")
+
+// etag is the entity tag of a version of an expense.
+func etag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ifMatch returns the version named by the If-Match header of a change, or 0
+// for "*". Only a single strong entity tag can name a version, so any other
+// value cannot match.
+func ifMatch(c echo.Context) (int64, error) {
+	header := strings.TrimSpace(c.Request().Header.Get("If-Match"))
+	switch header {
+	case "":
+		return 0, errors.ErrPreconditionRequired
+	case "*":
+		return 0, nil
+	}
+
+	if len(header) < 2 || header[0] != '"' || header[len(header)-1] != '"' {
+		return 0, errUnknownETag
+	}
+
+	version, err := strconv.ParseInt(header[1:len(header)-1], 10, 64)
+	if err != nil || version < 1 {
+		return 0, errUnknownETag
+	}
+
+	return version, nil
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpenseChanges_RequireIfMatchFirst(t *testing.T) {
+	ctrl := &expenseController{store: &data.Store{}}
+
+	tests := []struct {
+		name    string
+		method  string
+		handler echo.HandlerFunc
+	}{
+		{name: "PUT", method: http.MethodPut, handler: ctrl.handleUpdateExpense},
+		{name: "PATCH", method: http.MethodPatch, handler: ctrl.handlePatchExpense},
+		{name: "DELETE", method: http.MethodDelete, handler: ctrl.handleDeleteExpense},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/expenses/e1", strings.NewReader(`{"amount":`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			err := tt.handler(c)
+
+			assert.ErrorIs(t, err, errors.ErrPreconditionRequired, "A missing If-Match should win over a bad body")
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/dto"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type exportController struct {
+	store   *data.Store
+	timeout time.Duration
+}
+
+// ConfigureExportRoutes registers the exports, which may take up to timeout
+// to send instead of the server's write timeout.
+func ConfigureExportRoutes(group *echo.Group, store *data.Store, timeout time.Duration) {
+	ctrl := &exportController{store: store, timeout: timeout}
+
+	group.GET("/expenses", ctrl.handleExportExpenses)
+}
+
+// handleExportExpenses takes the filters of GET /expenses and the export
+// options from the query string, and streams the file as it is read.
+func (ctrl *exportController) handleExportExpenses(c echo.Context) error {
+	var query dto.ExpenseQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	var options dto.ExportOptionsDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &options); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewExportExpensesUseCase(*ctrl.store)
+
+	export, err := uc.Execute(middleware.UserID(c), query, options)
+	if err != nil {
+		return err
+	}
+
+	res := c.Response()
+	err = http.NewResponseController(res).SetWriteDeadline(time.Now().Add(ctrl.timeout))
+	if err != nil && !stdErrors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("failed to extend write deadline: %w", err)
+	}
+
+	res.Header().Set(echo.HeaderContentType, export.ContentType)
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", export.Filename))
+	res.WriteHeader(200)
+
+	if err := export.Write(res); err != nil {
+		// The status line is already sent, so the only way to tell the
+		// client the file is incomplete is to drop the connection.
+		log.Print("Failed to export expenses: ", err)
+		panic(http.ErrAbortHandler)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/expenses/entity"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSlowExpenseRepository streams the same expense a few times, pausing
+// before each
+type MockSlowExpenseRepository struct {
+	data.ExpenseRepository
+
+	expense entity.Expense
+	count   int
+	pause   time.Duration
+}
+
+func (m *MockSlowExpenseRepository) Stream(filter data.ExpenseFilter, fn func(expense entity.Expense) error) error {
+	for range m.count {
+		time.Sleep(m.pause)
+		if err := fn(m.expense); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestExportExpenses_OutlivesWriteTimeout(t *testing.T) {
+	expense, err := entity.NewExpense(1, 1250, "Lunch", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), entity.VariableExpense)
+	require.NoError(t, err)
+
+	e := echo.New()
+	store := &data.Store{Expenses: &MockSlowExpenseRepository{expense: *expense, count: 3, pause: 50 * time.Millisecond}}
+	ConfigureExportRoutes(e.Group("/exports"), store, time.Minute)
+
+	srv := httptest.NewUnstartedServer(e)
+	srv.Config.WriteTimeout = 50 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/exports/expenses?format=jsonl")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	lines := 0
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		lines++
+	}
+	require.NoError(t, scanner.Err(), "The export should not be cut off")
+	assert.Equal(t, 3, lines, "Every expense should be exported")
+}
@@ -0,0 +1,141 @@
+package controller
+
+import (
+	stdErrors "errors"
+	"io"
+	"mime"
+
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/imports/dto"
+	"github.com/MarioGN/finance-manager-api/internal/imports/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type importController struct {
+	store *data.Store
+}
+
+func ConfigureImportRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &importController{store: store}
+
+	group.POST("/csv", ctrl.handlePreviewCSV)
+	group.POST("/ofx", ctrl.handlePreviewStatement(usecase.StatementOFX))
+	group.POST("/camt053", ctrl.handlePreviewStatement(usecase.StatementCAMT053))
+	group.GET("/:id", ctrl.handleGetImportByID)
+	group.DELETE("/:id", ctrl.handleDeleteImport)
+	group.POST("/:id/commit", ctrl.handleCommitImport)
+}
+
+// handlePreviewCSV accepts the statement either as the "file" field of a
+// multipart form, with the column mapping in the other fields, or as a raw
+// text/csv body with the mapping in the query string.
+func (ctrl *importController) handlePreviewCSV(c echo.Context) error {
+	var mapping dto.CSVMappingDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &mapping); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType == echo.MIMEMultipartForm {
+		if err := (&echo.DefaultBinder{}).BindBody(c, &mapping); err != nil {
+			return errors.ErrInvalidRequest
+		}
+	}
+
+	file, filename, err := openStatement(c)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	uc := usecase.NewPreviewCSVUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), filename, file, mapping)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+// handlePreviewStatement accepts an OFX, QFX or CAMT.053 statement either as
+// the "file" field of a multipart form or as the raw body.
+func (ctrl *importController) handlePreviewStatement(format usecase.StatementFormat) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		file, filename, err := openStatement(c)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		uc := usecase.NewPreviewStatementUseCase(*ctrl.store)
+
+		res, err := uc.Execute(middleware.UserID(c), format, filename, file)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(201, res)
+	}
+}
+
+// openStatement returns the uploaded statement: the "file" field of a
+// multipart form, or else the request body. A missing file is a validation
+// error of the file field.
+func openStatement(c echo.Context) (io.ReadCloser, string, error) {
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != echo.MIMEMultipartForm {
+		return c.Request().Body, "", nil
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return nil, "", errors.Field("/file", stdErrors.New("the statement must be sent in the file field"))
+	}
+
+	upload, err := header.Open()
+	if err != nil {
+		return nil, "", errors.Field("/file", stdErrors.New("the statement could not be read"))
+	}
+
+	return upload, header.Filename, nil
+}
+
+func (ctrl *importController) handleGetImportByID(c echo.Context) error {
+	uc := usecase.NewGetImportUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *importController) handleDeleteImport(c echo.Context) error {
+	uc := usecase.NewDeleteImportUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (ctrl *importController) handleCommitImport(c echo.Context) error {
+	var req dto.CommitDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewCommitImportUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/dto"
+	"github.com/MarioGN/finance-manager-api/internal/incomes/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type incomeController struct {
+	store *data.Store
+}
+
+func ConfigureIncomeRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &incomeController{store: store}
+
+	group.GET("", ctrl.handleGetIncomes)
+	group.POST("", ctrl.handleCreateIncome)
+	group.GET("/:id", ctrl.handleGetIncomeByID)
+	group.PUT("/:id", ctrl.handleUpdateIncome)
+	group.DELETE("/:id", ctrl.handleDeleteIncome)
+}
+
+func (ctrl *incomeController) handleGetIncomes(c echo.Context) error {
+	var query dto.IncomeQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetIncomesUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *incomeController) handleCreateIncome(c echo.Context) error {
+	var req dto.IncomeDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewCreateIncomeUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+func (ctrl *incomeController) handleGetIncomeByID(c echo.Context) error {
+	uc := usecase.NewGetIncomeUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *incomeController) handleUpdateIncome(c echo.Context) error {
+	var req dto.IncomeDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewUpdateIncomeUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *incomeController) handleDeleteIncome(c echo.Context) error {
+	uc := usecase.NewDeleteIncomeUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/dto"
+	"github.com/MarioGN/finance-manager-api/internal/recurring/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type recurringController struct {
+	store *data.Store
+}
+
+func ConfigureRecurringRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &recurringController{store: store}
+
+	group.GET("", ctrl.handleGetRules)
+	group.POST("", ctrl.handleCreateRule)
+	group.GET("/upcoming", ctrl.handleGetUpcoming)
+	group.GET("/:id", ctrl.handleGetRuleByID)
+	group.PUT("/:id", ctrl.handleUpdateRule)
+	group.DELETE("/:id", ctrl.handleDeleteRule)
+	group.POST("/:id/pause", ctrl.handlePauseRule)
+	group.POST("/:id/resume", ctrl.handleResumeRule)
+	group.POST("/:id/skip", ctrl.handleSkipOccurrence)
+}
+
+func (ctrl *recurringController) handleGetRules(c echo.Context) error {
+	uc := usecase.NewGetRulesUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *recurringController) handleCreateRule(c echo.Context) error {
+	var req dto.RuleDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewCreateRuleUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+func (ctrl *recurringController) handleGetUpcoming(c echo.Context) error {
+	var query dto.UpcomingQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetUpcomingUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *recurringController) handleGetRuleByID(c echo.Context) error {
+	uc := usecase.NewGetRuleUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *recurringController) handleUpdateRule(c echo.Context) error {
+	var req dto.RuleDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewUpdateRuleUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *recurringController) handleDeleteRule(c echo.Context) error {
+	uc := usecase.NewDeleteRuleUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (ctrl *recurringController) handlePauseRule(c echo.Context) error {
+	return ctrl.setPaused(c, true)
+}
+
+func (ctrl *recurringController) handleResumeRule(c echo.Context) error {
+	return ctrl.setPaused(c, false)
+}
+
+func (ctrl *recurringController) setPaused(c echo.Context, pause bool) error {
+	uc := usecase.NewPauseRuleUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), pause)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *recurringController) handleSkipOccurrence(c echo.Context) error {
+	var req dto.SkipDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewSkipOccurrenceUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id"), req); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
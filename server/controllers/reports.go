@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/reports/dto"
+	"github.com/MarioGN/finance-manager-api/internal/reports/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type reportController struct {
+	store *data.Store
+}
+
+func ConfigureReportRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &reportController{store: store}
+
+	group.GET("/summary", ctrl.handleGetSummary)
+	group.GET("/cashflow", ctrl.handleGetCashflow)
+}
+
+func (ctrl *reportController) handleGetSummary(c echo.Context) error {
+	var query dto.SummaryQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetSummaryUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *reportController) handleGetCashflow(c echo.Context) error {
+	var query dto.CashflowQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetCashflowUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
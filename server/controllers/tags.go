@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/tags/dto"
+	"github.com/MarioGN/finance-manager-api/internal/tags/usecase"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type tagController struct {
+	store *data.Store
+}
+
+func ConfigureTagRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &tagController{store: store}
+
+	group.GET("", ctrl.handleGetTags)
+	group.PUT("/:id", ctrl.handleRenameTag)
+	group.POST("/:id/merge", ctrl.handleMergeTag)
+	group.DELETE("/:id", ctrl.handleDeleteTag)
+}
+
+func (ctrl *tagController) handleGetTags(c echo.Context) error {
+	uc := usecase.NewGetTagsUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *tagController) handleRenameTag(c echo.Context) error {
+	var req dto.RenameTagDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewRenameTagUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *tagController) handleMergeTag(c echo.Context) error {
+	var req dto.MergeTagDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewMergeTagUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *tagController) handleDeleteTag(c echo.Context) error {
+	uc := usecase.NewDeleteTagUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/dto"
+	"github.com/MarioGN/finance-manager-api/internal/notifications/usecase"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type webhookController struct {
+	store *data.Store
+}
+
+func ConfigureWebhookRoutes(group *echo.Group, store *data.Store) {
+	ctrl := &webhookController{store: store}
+
+	group.GET("", ctrl.handleGetWebhooks)
+	group.POST("", ctrl.handleCreateWebhook)
+	group.GET("/:id", ctrl.handleGetWebhookByID)
+	group.PUT("/:id", ctrl.handleUpdateWebhook)
+	group.DELETE("/:id", ctrl.handleDeleteWebhook)
+	group.GET("/:id/deliveries", ctrl.handleGetDeliveries)
+}
+
+func (ctrl *webhookController) handleGetWebhooks(c echo.Context) error {
+	uc := usecase.NewGetWebhooksUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *webhookController) handleCreateWebhook(c echo.Context) error {
+	var req dto.WebhookDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewCreateWebhookUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, res)
+}
+
+func (ctrl *webhookController) handleGetWebhookByID(c echo.Context) error {
+	uc := usecase.NewGetWebhookUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *webhookController) handleUpdateWebhook(c echo.Context) error {
+	var req dto.WebhookDTO
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	uc := usecase.NewUpdateWebhookUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
+
+func (ctrl *webhookController) handleDeleteWebhook(c echo.Context) error {
+	uc := usecase.NewDeleteWebhookUseCase(*ctrl.store)
+
+	if err := uc.Execute(middleware.UserID(c), c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(204)
+}
+
+func (ctrl *webhookController) handleGetDeliveries(c echo.Context) error {
+	var query dto.DeliveryQueryDTO
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		return errors.ErrInvalidRequest
+	}
+
+	uc := usecase.NewGetDeliveriesUseCase(*ctrl.store)
+
+	res, err := uc.Execute(middleware.UserID(c), c.Param("id"), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, res)
+}
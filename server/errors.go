@@ -0,0 +1,76 @@
+package server
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/labstack/echo/v4"
+)
+
+const problemContentType = "application/problem+json"
+
+var kindStatus = map[errors.Kind]int{
+	errors.KindValidation:           http.StatusBadRequest,
+	errors.KindNotFound:             http.StatusNotFound,
+	errors.KindConflict:             http.StatusConflict,
+	errors.KindForbidden:            http.StatusForbidden,
+	errors.KindUnauthorized:         http.StatusUnauthorized,
+	errors.KindUnprocessable:        http.StatusUnprocessableEntity,
+	errors.KindPreconditionFailed:   http.StatusPreconditionFailed,
+	errors.KindPreconditionRequired: http.StatusPreconditionRequired,
+}
+
+// problem is an RFC 7807 problem details body. No problem type is defined
+// beyond its status, so type is always about:blank and title the status text.
+type problem struct {
+	Type     string              `json:"type"`
+	Title    string              `json:"title"`
+	Status   int                 `json:"status"`
+	Detail   string              `json:"detail,omitempty"`
+	Instance string              `json:"instance,omitempty"`
+	Errors   []errors.FieldError `json:"errors,omitempty"`
+}
+
+// handleError is the HTTPErrorHandler of the server: controllers return the
+// errors of the use cases as they are, and the status comes from their kind.
+func handleError(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	body := problem{Type: "about:blank", Instance: c.Request().URL.Path}
+
+	var httpErr *echo.HTTPError
+	if stdErrors.As(err, &httpErr) {
+		// Routing and middleware errors from Echo itself.
+		body.Status = httpErr.Code
+		if message := fmt.Sprint(httpErr.Message); message != http.StatusText(httpErr.Code) {
+			body.Detail = message
+		}
+	} else if status, ok := kindStatus[errors.KindOf(err)]; ok {
+		body.Status = status
+		body.Detail = errors.Detail(err)
+		body.Errors = errors.Fields(err)
+	} else {
+		body.Status = http.StatusInternalServerError
+	}
+
+	if body.Status >= 500 {
+		c.Logger().Error(err)
+	}
+
+	body.Title = http.StatusText(body.Status)
+
+	var writeErr error
+	if c.Request().Method == http.MethodHead {
+		writeErr = c.NoContent(body.Status)
+	} else {
+		c.Response().Header().Set(echo.HeaderContentType, problemContentType)
+		writeErr = c.JSON(body.Status, body)
+	}
+	if writeErr != nil {
+		c.Logger().Error(writeErr)
+	}
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleError(t *testing.T) {
+	errInvalidThing := errors.Validation("invalid thing")
+	errThingNotFound := errors.NotFound("thing not found")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected problem
+	}{
+		{
+			name: "Validation with fields",
+			err: fmt.Errorf("failed to create thing: %w", fmt.Errorf("%w: %w", errInvalidThing,
+				errors.Field("/amount", stdErrors.New("amount must be greater than zero")))),
+			expected: problem{
+				Type: "about:blank", Title: "Bad Request", Status: 400, Instance: "/things/1",
+				Detail: "invalid thing: amount must be greater than zero",
+				Errors: []errors.FieldError{{Pointer: "/amount", Detail: "amount must be greater than zero"}},
+			},
+		},
+		{
+			name:     "Not found",
+			err:      fmt.Errorf("failed to find thing: %w", errThingNotFound),
+			expected: problem{Type: "about:blank", Title: "Not Found", Status: 404, Instance: "/things/1", Detail: "thing not found"},
+		},
+		{
+			name:     "Conflict",
+			err:      errors.Conflict("thing already exists"),
+			expected: problem{Type: "about:blank", Title: "Conflict", Status: 409, Instance: "/things/1", Detail: "thing already exists"},
+		},
+		{
+			name:     "Forbidden",
+			err:      errors.Forbidden("thing is read-only"),
+			expected: problem{Type: "about:blank", Title: "Forbidden", Status: 403, Instance: "/things/1", Detail: "thing is read-only"},
+		},
+		{
+			name:     "Precondition failed",
+			err:      fmt.Errorf("failed to save thing: %w", errors.PreconditionFailed("thing was changed")),
+			expected: problem{Type: "about:blank", Title: "Precondition Failed", Status: 412, Instance: "/things/1", Detail: "thing was changed"},
+		},
+		{
+			name:     "Precondition required",
+			err:      errors.ErrPreconditionRequired,
+			expected: problem{Type: "about:blank", Title: "Precondition Required", Status: 428, Instance: "/things/1", Detail: "If-Match header is required"},
+		},
+		{
+			name:     "Internal errors hide their message",
+			err:      fmt.Errorf("failed to find thing: %w", stdErrors.New("database is locked")),
+			expected: problem{Type: "about:blank", Title: "Internal Server Error", Status: 500, Instance: "/things/1"},
+		},
+		{
+			name:     "Echo errors",
+			err:      echo.NewHTTPError(http.StatusRequestEntityTooLarge, "body is too large"),
+			expected: problem{Type: "about:blank", Title: "Request Entity Too Large", Status: 413, Instance: "/things/1", Detail: "body is too large"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Logger.SetOutput(io.Discard)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/things/1?verbose=1", nil), rec)
+
+			handleError(tt.err, c)
+
+			assert.Equal(t, tt.expected.Status, rec.Code)
+			assert.Equal(t, problemContentType, rec.Header().Get(echo.HeaderContentType))
+
+			var body problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.expected, body)
+		})
+	}
+
+	t.Run("Committed responses are left alone", func(t *testing.T) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/things/1", nil), rec)
+		require.NoError(t, c.String(200, "partial"))
+
+		handleError(errThingNotFound, c)
+
+		assert.Equal(t, 200, rec.Code)
+		assert.Equal(t, "partial", rec.Body.String())
+	})
+}
+
+func TestServer_UnknownRouteIsAProblem(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/nowhere", nil))
+
+	assert.Equal(t, 404, rec.Code)
+	assert.Equal(t, problemContentType, rec.Header().Get(echo.HeaderContentType))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"instance":"/nowhere"}`, rec.Body.String())
+}
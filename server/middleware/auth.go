@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/MarioGN/finance-manager-api/internal/auth/token"
+	"github.com/MarioGN/finance-manager-api/pkg/errors"
+	"github.com/labstack/echo/v4"
+)
+
+const userIDKey = "user_id"
+
+// RequireAuth rejects requests without a valid "Authorization: Bearer"
+// token and stores the authenticated user ID in the request context.
+func RequireAuth(tokens *token.Manager) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+
+			scheme, raw, found := strings.Cut(header, " ")
+			if !found || !strings.EqualFold(scheme, "Bearer") || raw == "" {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return errors.ErrUnauthorized
+			}
+
+			userID, err := tokens.Verify(raw)
+			if err != nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+				return errors.ErrUnauthorized
+			}
+
+			c.Set(userIDKey, userID)
+			return next(c)
+		}
+	}
+}
+
+// UserID returns the authenticated user ID set by RequireAuth.
+func UserID(c echo.Context) int64 {
+	id, _ := c.Get(userIDKey).(int64)
+	return id
+}
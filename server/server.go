@@ -1,31 +1,133 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MarioGN/finance-manager-api/config"
 	"github.com/MarioGN/finance-manager-api/data"
+	"github.com/MarioGN/finance-manager-api/internal/auth/token"
 	controller "github.com/MarioGN/finance-manager-api/server/controllers"
+	"github.com/MarioGN/finance-manager-api/server/middleware"
 
 	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/labstack/gommon/log"
 )
 
+var logLevels = map[string]log.Lvl{
+	"debug": log.DEBUG,
+	"info":  log.INFO,
+	"warn":  log.WARN,
+	"error": log.ERROR,
+	"off":   log.OFF,
+}
+
 type server struct {
-	echo  *echo.Echo
-	store *data.Store
+	echo   *echo.Echo
+	config *config.Config
+	store  *data.Store
+	tokens *token.Manager
 }
 
-func New(store *data.Store) *server {
+func New(cfg *config.Config, store *data.Store, tokens *token.Manager) *server {
+	e := echo.New()
+	e.Logger.SetLevel(logLevels[cfg.LogLevel])
+	e.Server.ReadTimeout = cfg.ReadTimeout
+	e.Server.WriteTimeout = cfg.WriteTimeout
+	e.HTTPErrorHandler = handleError
+
+	if len(cfg.CORSOrigins) > 0 {
+		e.Use(echoMiddleware.CORSWithConfig(echoMiddleware.CORSConfig{
+			AllowOrigins: cfg.CORSOrigins,
+			// Browsers need the ETag of an expense to send it back in If-Match.
+			ExposeHeaders: []string{"ETag"},
+		}))
+	}
+
 	return &server{
-		echo:  echo.New(),
-		store: store,
+		echo:   e,
+		config: cfg,
+		store:  store,
+		tokens: tokens,
 	}
 }
 
-func (s *server) Start() error {
+// Start serves HTTP until ctx is cancelled, then stops accepting
+// connections and waits up to the configured shutdown timeout for
+// in-flight requests to finish.
+func (s *server) Start(ctx context.Context) error {
 	s.configureRoutes()
-	s.echo.Logger.Fatal(s.echo.Start(":3000"))
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.echo.Start(s.config.ListenAddr)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server stopped unexpectedly: %w", err)
+	case <-ctx.Done():
+	}
+
+	s.echo.Logger.Info("shutting down, draining in-flight requests")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
+	defer cancel()
+
+	if err := s.echo.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down gracefully: %w", err)
+	}
+
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
 	return nil
 }
 
 func (s *server) configureRoutes() {
-	expensesGroup := s.echo.Group("/expenses")
+	authGroup := s.echo.Group("/auth")
+	controller.ConfigureAuthRoutes(authGroup, s.store, s.tokens)
+
+	expensesGroup := s.echo.Group("/expenses", middleware.RequireAuth(s.tokens))
 	controller.ConfigureExpenseRoutes(expensesGroup, s.store)
+
+	incomesGroup := s.echo.Group("/incomes", middleware.RequireAuth(s.tokens))
+	controller.ConfigureIncomeRoutes(incomesGroup, s.store)
+
+	accountsGroup := s.echo.Group("/accounts", middleware.RequireAuth(s.tokens))
+	controller.ConfigureAccountRoutes(accountsGroup, s.store)
+
+	transfersGroup := s.echo.Group("/transfers", middleware.RequireAuth(s.tokens))
+	controller.ConfigureTransferRoutes(transfersGroup, s.store)
+
+	recurringGroup := s.echo.Group("/recurring", middleware.RequireAuth(s.tokens))
+	controller.ConfigureRecurringRoutes(recurringGroup, s.store)
+
+	budgetsGroup := s.echo.Group("/budgets", middleware.RequireAuth(s.tokens))
+	controller.ConfigureBudgetRoutes(budgetsGroup, s.store)
+
+	importsGroup := s.echo.Group("/imports", middleware.RequireAuth(s.tokens))
+	controller.ConfigureImportRoutes(importsGroup, s.store)
+
+	exportsGroup := s.echo.Group("/exports", middleware.RequireAuth(s.tokens))
+	controller.ConfigureExportRoutes(exportsGroup, s.store, s.config.ExportTimeout)
+
+	webhooksGroup := s.echo.Group("/webhooks", middleware.RequireAuth(s.tokens))
+	controller.ConfigureWebhookRoutes(webhooksGroup, s.store)
+
+	categoriesGroup := s.echo.Group("/categories", middleware.RequireAuth(s.tokens))
+	controller.ConfigureCategoryRoutes(categoriesGroup, s.store)
+
+	tagsGroup := s.echo.Group("/tags", middleware.RequireAuth(s.tokens))
+	controller.ConfigureTagRoutes(tagsGroup, s.store)
+
+	exchangeRatesGroup := s.echo.Group("/exchange-rates", middleware.RequireAuth(s.tokens))
+	controller.ConfigureExchangeRateRoutes(exchangeRatesGroup, s.store)
+
+	reportsGroup := s.echo.Group("/reports", middleware.RequireAuth(s.tokens))
+	controller.ConfigureReportRoutes(reportsGroup, s.store)
 }
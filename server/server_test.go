@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MarioGN/finance-manager-api/config"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *server {
+	t.Helper()
+
+	cfg := config.Default()
+	cfg.ListenAddr = "127.0.0.1:0"
+	cfg.ShutdownTimeout = 5 * time.Second
+
+	s := New(cfg, nil, nil)
+	s.echo.HideBanner = true
+	s.echo.HidePort = true
+
+	return s
+}
+
+func TestServer_StartDrainsInFlightRequestsOnShutdown(t *testing.T) {
+	s := newTestServer(t)
+
+	started := make(chan struct{})
+	s.echo.GET("/slow", func(c echo.Context) error {
+		close(started)
+		time.Sleep(300 * time.Millisecond)
+		return c.String(http.StatusOK, "done")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	startErr := make(chan error, 1)
+	go func() { startErr <- s.Start(ctx) }()
+
+	addr := waitForListener(t, s)
+
+	type response struct {
+		status int
+		body   string
+		err    error
+	}
+	responses := make(chan response, 1)
+	go func() {
+		res, err := http.Get("http://" + addr + "/slow")
+		if err != nil {
+			responses <- response{err: err}
+			return
+		}
+		defer res.Body.Close()
+		body, _ := io.ReadAll(res.Body)
+		responses <- response{status: res.StatusCode, body: string(body)}
+	}()
+
+	<-started
+	cancel()
+
+	res := <-responses
+	require.NoError(t, res.err, "In-flight request should complete during shutdown")
+	assert.Equal(t, http.StatusOK, res.status)
+	assert.Equal(t, "done", res.body)
+
+	select {
+	case err := <-startErr:
+		assert.NoError(t, err, "Start should return nil after a graceful shutdown")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Start did not return after shutdown")
+	}
+}
+
+func TestServer_StartReturnsListenErrors(t *testing.T) {
+	s := newTestServer(t)
+	s.config.ListenAddr = "256.0.0.1:0"
+
+	err := s.Start(context.Background())
+	assert.Error(t, err, "Start should return listen errors instead of exiting")
+}
+
+func waitForListener(t *testing.T, s *server) string {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if addr := s.echo.ListenerAddr(); addr != nil {
+			return addr.String()
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatal("server did not start listening")
+	return ""
+}